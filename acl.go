@@ -32,6 +32,7 @@ type ACL struct {
 	Meter      []string
 	Severity   string
 	ExternalID map[interface{}]interface{}
+	PopulatedColumns
 }
 
 func (odbi *ovndb) getACLUUIDByRow(entityType EntityType, entity string, row OVNRow) (string, error) {
@@ -70,27 +71,27 @@ func (odbi *ovndb) getACLUUIDByRow(entityType EntityType, entity string, row OVN
 								for field, value := range row {
 									switch field {
 									case "action":
-										if cacheACL.Fields["action"].(string) != value {
+										if rowString(cacheACL, "action") != value {
 											goto unmatched
 										}
 									case "direction":
-										if cacheACL.Fields["direction"].(string) != value {
+										if rowString(cacheACL, "direction") != value {
 											goto unmatched
 										}
 									case "match":
-										if cacheACL.Fields["match"].(string) != value {
+										if rowString(cacheACL, "match") != value {
 											goto unmatched
 										}
 									case "priority":
-										if cacheACL.Fields["priority"].(int) != value {
+										if rowInt(cacheACL, "priority") != value {
 											goto unmatched
 										}
 									case "log":
-										if cacheACL.Fields["log"].(bool) != value {
+										if rowBool(cacheACL, "log") != value {
 											goto unmatched
 										}
 									case "external_ids":
-										if value != nil && !odbi.oMapContians(cacheACL.Fields["external_ids"].(libovsdb.OvsMap).GoMap, value.(*libovsdb.OvsMap).GoMap) {
+										if value != nil && !odbi.oMapContians(rowMap(cacheACL, "external_ids"), value.(*libovsdb.OvsMap).GoMap) {
 											goto unmatched
 										}
 									}
@@ -111,27 +112,27 @@ func (odbi *ovndb) getACLUUIDByRow(entityType EntityType, entity string, row OVN
 						for field, value := range row {
 							switch field {
 							case "action":
-								if cacheACL.Fields["action"].(string) != value {
+								if rowString(cacheACL, "action") != value {
 									goto out
 								}
 							case "direction":
-								if cacheACL.Fields["direction"].(string) != value {
+								if rowString(cacheACL, "direction") != value {
 									goto out
 								}
 							case "match":
-								if cacheACL.Fields["match"].(string) != value {
+								if rowString(cacheACL, "match") != value {
 									goto out
 								}
 							case "priority":
-								if cacheACL.Fields["priority"].(int) != value {
+								if rowInt(cacheACL, "priority") != value {
 									goto out
 								}
 							case "log":
-								if cacheACL.Fields["log"].(bool) != value {
+								if rowBool(cacheACL, "log") != value {
 									goto out
 								}
 							case "external_ids":
-								if value != nil && !odbi.oMapContians(cacheACL.Fields["external_ids"].(libovsdb.OvsMap).GoMap, value.(*libovsdb.OvsMap).GoMap) {
+								if value != nil && !odbi.oMapContians(rowMap(cacheACL, "external_ids"), value.(*libovsdb.OvsMap).GoMap) {
 									goto out
 								}
 							}
@@ -383,7 +384,7 @@ func (odbi *ovndb) rowToACL(uuid string) *ACL {
 	var meter []string
 	switch cacheACL.Fields["meter"].(type) {
 	case string:
-		meter = []string{cacheACL.Fields["meter"].(string)}
+		meter = []string{rowString(cacheACL, "meter")}
 	case libovsdb.OvsSet:
 		for _, a := range cacheACL.Fields["meter"].(libovsdb.OvsSet).GoSet {
 			meter = append(meter, a.(string))
@@ -394,7 +395,7 @@ func (odbi *ovndb) rowToACL(uuid string) *ACL {
 	severity := ""
 	switch cacheACL.Fields["severity"].(type) {
 	case string:
-		severity = cacheACL.Fields["severity"].(string)
+		severity = rowString(cacheACL, "severity")
 	case libovsdb.OvsSet:
 		for _, a := range cacheACL.Fields["severity"].(libovsdb.OvsSet).GoSet {
 			severity = a.(string)
@@ -404,17 +405,18 @@ func (odbi *ovndb) rowToACL(uuid string) *ACL {
 
 	acl := &ACL{
 		UUID:       uuid,
-		Name:       cacheACL.Fields["name"].(string),
-		Action:     cacheACL.Fields["action"].(string),
-		Direction:  cacheACL.Fields["direction"].(string),
-		Match:      cacheACL.Fields["match"].(string),
-		Priority:   cacheACL.Fields["priority"].(int),
-		Log:        cacheACL.Fields["log"].(bool),
+		Name:       rowString(cacheACL, "name"),
+		Action:     rowString(cacheACL, "action"),
+		Direction:  rowString(cacheACL, "direction"),
+		Match:      rowString(cacheACL, "match"),
+		Priority:   rowInt(cacheACL, "priority"),
+		Log:        rowBool(cacheACL, "log"),
 		Meter:      meter,
 		Severity:   severity,
-		ExternalID: cacheACL.Fields["external_ids"].(libovsdb.OvsMap).GoMap,
+		ExternalID: rowMap(cacheACL, "external_ids"),
 	}
 
+	acl.PopulatedColumns = odbi.populated[TableACL]
 	return acl
 }
 
@@ -457,19 +457,19 @@ func TestACLs(t *testing.T) {
 			PORT_GROUP, PG_TEST_PG1,
 			[]aclTest{
 				{
-					ACL{"", "", "drop", "from-lport", MATCH3, 1001, false, []string{""}, "", nil},
+					ACL{"", "", "drop", "from-lport", MATCH3, 1001, false, []string{""}, "", nil, nil},
 					ACL_NAME_2, MATCH_SECOND, METER1, SEVERITY_INFO,
 				},
 				{
-					ACL{"", ACL_NAME_1, "drop", "to-lport", MATCH, 1001, true, []string{METER1}, SEVERITY_ALERT, nil},
+					ACL{"", ACL_NAME_1, "drop", "to-lport", MATCH, 1001, true, []string{METER1}, SEVERITY_ALERT, nil, nil},
 					ACL_NAME_3, MATCH_SECOND, METER2, SEVERITY_INFO,
 				},
 				{
-					ACL{"", ACL_NAME_2, "drop", "from-lport", MATCH, 1002, true, []string{METER2}, SEVERITY_INFO, map[interface{}]interface{}{"A": "a", "B": "b"}},
+					ACL{"", ACL_NAME_2, "drop", "from-lport", MATCH, 1002, true, []string{METER2}, SEVERITY_INFO, map[interface{}]interface{}{"A": "a", "B": "b"}, nil},
 					ACL_NAME_4, MATCH3, METER1, SEVERITY_WARNING,
 				},
 				{
-					ACL{"", ACL_NAME_3, "drop", "to-lport", MATCH, 1002, true, []string{METER1}, SEVERITY_ALERT, map[interface{}]interface{}{"A": "b", "B": "a"}},
+					ACL{"", ACL_NAME_3, "drop", "to-lport", MATCH, 1002, true, []string{METER1}, SEVERITY_ALERT, map[interface{}]interface{}{"A": "b", "B": "a"}, nil},
 					ACL_NAME_5, MATCH_SECOND, METER2, SEVERITY_INFO,
 				},
 			},
@@ -478,19 +478,19 @@ func TestACLs(t *testing.T) {
 			LOGICAL_SWITCH, PG_TEST_LS1,
 			[]aclTest{
 				{
-					ACL{"", "", "drop", "from-lport", MATCH3, 1001, false, []string{""}, "", nil},
+					ACL{"", "", "drop", "from-lport", MATCH3, 1001, false, []string{""}, "", nil, nil},
 					ACL_NAME_2, MATCH_SECOND, METER1, SEVERITY_INFO,
 				},
 				{
-					ACL{"", ACL_NAME_1, "drop", "to-lport", MATCH, 1001, true, []string{METER1}, SEVERITY_ALERT, nil},
+					ACL{"", ACL_NAME_1, "drop", "to-lport", MATCH, 1001, true, []string{METER1}, SEVERITY_ALERT, nil, nil},
 					ACL_NAME_3, MATCH_SECOND, METER2, SEVERITY_INFO,
 				},
 				{
-					ACL{"", ACL_NAME_2, "drop", "from-lport", MATCH, 1002, true, []string{METER2}, SEVERITY_INFO, map[interface{}]interface{}{"A": "a", "B": "b"}},
+					ACL{"", ACL_NAME_2, "drop", "from-lport", MATCH, 1002, true, []string{METER2}, SEVERITY_INFO, map[interface{}]interface{}{"A": "a", "B": "b"}, nil},
 					ACL_NAME_4, MATCH3, METER1, SEVERITY_WARNING,
 				},
 				{
-					ACL{"", ACL_NAME_3, "drop", "to-lport", MATCH, 1002, true, []string{METER1}, SEVERITY_ALERT, map[interface{}]interface{}{"A": "b", "B": "a"}},
+					ACL{"", ACL_NAME_3, "drop", "to-lport", MATCH, 1002, true, []string{METER1}, SEVERITY_ALERT, map[interface{}]interface{}{"A": "b", "B": "a"}, nil},
 					ACL_NAME_5, MATCH_SECOND, METER2, SEVERITY_INFO,
 				},
 			},
@@ -26,6 +26,7 @@ type AddressSet struct {
 	Name       string
 	Addresses  []string
 	ExternalID map[interface{}]interface{}
+	PopulatedColumns
 }
 
 func (odbi *ovndb) asUpdateImp(name string, addrs []string, external_ids map[string]string) (*OvnCommand, error) {
@@ -122,29 +123,39 @@ func (odbi *ovndb) asListImp() ([]*AddressSet, error) {
 	}
 
 	listAS := make([]*AddressSet, 0, len(cacheAddressSet))
-	for uuid, drows := range cacheAddressSet {
-		ta := &AddressSet{
-			UUID:       uuid,
-			Name:       drows.Fields["name"].(string),
-			ExternalID: drows.Fields["external_ids"].(libovsdb.OvsMap).GoMap,
-		}
-		addresses := []string{}
-		as := drows.Fields["addresses"]
-		switch as.(type) {
-		case libovsdb.OvsSet:
-			//TODO: is it possible return interface type directly instead of GoSet
-			if goset, ok := drows.Fields["addresses"].(libovsdb.OvsSet); ok {
-				for _, i := range goset.GoSet {
-					addresses = append(addresses, i.(string))
-				}
-			}
-		case string:
-			if v, ok := drows.Fields["addresses"].(string); ok {
-				addresses = append(addresses, v)
+	for uuid := range cacheAddressSet {
+		listAS = append(listAS, odbi.rowToAddressSet(uuid))
+	}
+	return listAS, nil
+}
+
+func (odbi *ovndb) rowToAddressSet(uuid string) *AddressSet {
+	drows, ok := odbi.cache[TableAddressSet][uuid]
+	if !ok {
+		return nil
+	}
+
+	ta := &AddressSet{
+		UUID:       uuid,
+		Name:       rowString(drows, "name"),
+		ExternalID: rowMap(drows, "external_ids"),
+	}
+	addresses := []string{}
+	as := drows.Fields["addresses"]
+	switch as.(type) {
+	case libovsdb.OvsSet:
+		//TODO: is it possible return interface type directly instead of GoSet
+		if goset, ok := drows.Fields["addresses"].(libovsdb.OvsSet); ok {
+			for _, i := range goset.GoSet {
+				addresses = append(addresses, i.(string))
 			}
 		}
-		ta.Addresses = addresses
-		listAS = append(listAS, ta)
+	case string:
+		if v, ok := drows.Fields["addresses"].(string); ok {
+			addresses = append(addresses, v)
+		}
 	}
-	return listAS, nil
+	ta.Addresses = addresses
+	ta.PopulatedColumns = odbi.populated[TableAddressSet]
+	return ta
 }
@@ -41,7 +41,21 @@ type Execution interface {
 // OVNDisconnectedCallback executed when ovn client disconnects
 type OVNDisconnectedCallback func()
 
-// OVNSignal notifies on changes to ovnnb
+// OVNConnectedCallback executed when ovn client connected for the first time
+type OVNConnectedCallback func()
+
+// OVNReconnectingCallback executed before each reconnection attempt, err is
+// the error of the previous attempt or nil before the first one
+type OVNReconnectingCallback func(attempt int, err error)
+
+// OVNReconnectedCallback executed when ovn client reconnected after attempts
+type OVNReconnectedCallback func(attempts int)
+
+// OVNSignal notifies on changes to ovnnb and ovnsb. Changes of any monitored
+// table, including those without callbacks here, can be handled with
+// Client.AddEventHandler. Callbacks are called in the order of the changes
+// from a dispatch goroutine after the cache was updated, so they may read
+// the cache of the client.
 type OVNSignal interface {
 	OnLogicalSwitchCreate(ls *LogicalSwitch)
 	OnLogicalSwitchDelete(ls *LogicalSwitch)
@@ -85,6 +99,35 @@ type OVNSignal interface {
 	OnEncapDelete(ch *Encap)
 }
 
+// OVNSignalUpdater notifies on modifications of an existing row with the
+// object before and after the change. It is optional, the callbacks are
+// called if Config.SignalCB also implements it, otherwise modifications are
+// reported to the OVNSignal create callbacks with the object after the change.
+type OVNSignalUpdater interface {
+	OnLogicalSwitchUpdate(old, new *LogicalSwitch)
+	OnLogicalPortUpdate(old, new *LogicalSwitchPort)
+	OnLogicalRouterUpdate(old, new *LogicalRouter)
+	OnLogicalRouterPortUpdate(old, new *LogicalRouterPort)
+	OnLogicalRouterStaticRouteUpdate(old, new *LogicalRouterStaticRoute)
+	OnACLUpdate(old, new *ACL)
+	OnDHCPOptionsUpdate(old, new *DHCPOptions)
+	OnQoSUpdate(old, new *QoS)
+	OnLoadBalancerUpdate(old, new *LoadBalancer)
+	OnMeterUpdate(old, new *Meter)
+	OnMeterBandUpdate(old, new *MeterBand)
+	OnChassisUpdate(old, new *Chassis)
+	OnEncapUpdate(old, new *Encap)
+}
+
+// OVNPortBindingSignal notifies on changes to the Port_Binding table of
+// ovnsb. It is optional, the callbacks are called if Config.SignalCB also
+// implements it.
+type OVNPortBindingSignal interface {
+	OnPortBindingCreate(pb *PortBinding)
+	OnPortBindingUpdate(old, new *PortBinding)
+	OnPortBindingDelete(pb *PortBinding)
+}
+
 // OVNNotifier ovnnb and ovnsb notifier
 type OVNNotifier interface {
 	Update(context interface{}, tableUpdates libovsdb.TableUpdates)
@@ -31,6 +31,7 @@ type Chassis struct {
 	NbCfg               int
 	TransportZones      []string
 	VtepLogicalSwitches []string
+	PopulatedColumns
 }
 
 func (odbi *ovndb) chassisAddImp(name string, hostname string, etype []string, ip string,
@@ -182,10 +183,10 @@ func (odbi *ovndb) rowToChassis(uuid string) (*Chassis, error) {
 	}
 	ch := &Chassis{
 		UUID:       uuid,
-		Name:       cacheChassis.Fields["name"].(string),
-		Hostname:   cacheChassis.Fields["hostname"].(string),
-		ExternalID: cacheChassis.Fields["external_ids"].(libovsdb.OvsMap).GoMap,
-		NbCfg:      cacheChassis.Fields["nb_cfg"].(int),
+		Name:       rowString(cacheChassis, "name"),
+		Hostname:   rowString(cacheChassis, "hostname"),
+		ExternalID: rowMap(cacheChassis, "external_ids"),
+		NbCfg:      rowInt(cacheChassis, "nb_cfg"),
 	}
 
 	if tz, ok := cacheChassis.Fields["transport_zones"]; ok {
@@ -226,5 +227,6 @@ func (odbi *ovndb) rowToChassis(uuid string) (*Chassis, error) {
 		}
 	}
 	ch.Encaps = encaps
+	ch.PopulatedColumns = odbi.populated[TableChassis]
 	return ch, nil
 }
@@ -27,6 +27,7 @@ type ChassisPrivate struct {
 	ExternalID map[interface{}]interface{}
 	Name       string
 	NbCfg      int
+	PopulatedColumns
 }
 
 func (odbi *ovndb) chassisPrivateAddImp(chName string,
@@ -80,9 +81,9 @@ func (odbi *ovndb) chassisPrivateDelImp(name string) (*OvnCommand, error) {
 
 func (odbi *ovndb) chassisPrivateListImp() ([]*ChassisPrivate, error) {
 	odbi.cachemutex.RLock()
-	cacheChassisPrivate, ok := odbi.cache[TableChassisPrivate]
-	odbi.cachemutex.RUnlock()
+	defer odbi.cachemutex.RUnlock()
 
+	cacheChassisPrivate, ok := odbi.cache[TableChassisPrivate]
 	if !ok {
 		return nil, ErrorSchema
 	}
@@ -102,9 +103,9 @@ func (odbi *ovndb) chassisPrivateGetImp(chassis string) ([]*ChassisPrivate, erro
 	var listChassisPrivate []*ChassisPrivate
 
 	odbi.cachemutex.RLock()
-	cacheChassisPrivate, ok := odbi.cache[TableChassisPrivate]
-	odbi.cachemutex.RUnlock()
+	defer odbi.cachemutex.RUnlock()
 
+	cacheChassisPrivate, ok := odbi.cache[TableChassisPrivate]
 	if !ok {
 		return nil, ErrorSchema
 	}
@@ -122,20 +123,17 @@ func (odbi *ovndb) chassisPrivateGetImp(chassis string) ([]*ChassisPrivate, erro
 }
 
 func (odbi *ovndb) rowToChassisPrivate(uuid string) (*ChassisPrivate, error) {
-
-	odbi.cachemutex.RLock()
 	cacheChassisPrivate, ok := odbi.cache[TableChassisPrivate][uuid]
-	odbi.cachemutex.RUnlock()
-
 	if !ok {
 		return nil, fmt.Errorf("row in chassis_private with uuid %s not found", uuid)
 	}
 
 	chPrivate := &ChassisPrivate{
 		UUID:       uuid,
-		ExternalID: cacheChassisPrivate.Fields["external_ids"].(libovsdb.OvsMap).GoMap,
-		Name:       cacheChassisPrivate.Fields["name"].(string),
-		NbCfg:      cacheChassisPrivate.Fields["nb_cfg"].(int),
+		ExternalID: rowMap(cacheChassisPrivate, "external_ids"),
+		Name:       rowString(cacheChassisPrivate, "name"),
+		NbCfg:      rowInt(cacheChassisPrivate, "nb_cfg"),
 	}
+	chPrivate.PopulatedColumns = odbi.populated[TableChassisPrivate]
 	return chPrivate, nil
 }
@@ -17,11 +17,13 @@
 package goovn
 
 import (
+	"context"
 	"fmt"
+	"reflect"
 	"sync"
+	"sync/atomic"
 
 	"crypto/tls"
-	"log"
 	"time"
 
 	"github.com/ebay/libovsdb"
@@ -216,6 +218,12 @@ type Client interface {
 	Execute(cmds ...*OvnCommand) error
 	// Same as Execute, but returns a UUID for each object created.
 	ExecuteR(cmds ...*OvnCommand) ([]string, error)
+	// Same as Execute, the request is abandoned when ctx is done
+	ExecuteContext(ctx context.Context, cmds ...*OvnCommand) error
+	// Same as ExecuteR, the request is abandoned when ctx is done
+	ExecuteRContext(ctx context.Context, cmds ...*OvnCommand) ([]string, error)
+	// Start a transaction that stages commands against a private view of the cache
+	NewTransaction() *Transaction
 
 	// Add chassis with given name
 	ChassisAdd(name string, hostname string, etype []string, ip string, external_ids map[string]string,
@@ -237,6 +245,66 @@ type Client interface {
 	// Get encaps by chassis name
 	EncapList(chname string) ([]*Encap, error)
 
+	// List all port bindings
+	PortBindingList() ([]*PortBinding, error)
+	// Get port binding by uuid
+	PortBindingGet(uuid string) (*PortBinding, error)
+	// Get port binding of given logical port
+	PortBindingGetByLogicalPort(lport string) (*PortBinding, error)
+	// List port bindings bound to chassis with given name or hostname
+	PortBindingListByChassis(chassis string) ([]*PortBinding, error)
+
+	// List all datapath bindings, Datapath_Binding must be in Config.TableCols
+	DatapathBindingList() ([]*DatapathBinding, error)
+	// Get datapath bindings by name or NB UUID of logical switch/router, Datapath_Binding must be in Config.TableCols
+	DatapathBindingGet(name string) ([]*DatapathBinding, error)
+	// List logical flows filtered by datapath, pipeline, table id and stage name, empty filters match all.
+	// Logical_Flow, Datapath_Binding and Logical_DP_Group are not monitored by default, as caching every
+	// logical flow is costly. Add them to Config.TableCols with the tables of SBTablesOrder the client uses,
+	// or ErrorSchema is returned.
+	LogicalFlowList(datapath, pipeline string, tableID *int, stageName string) ([]*LogicalFlow, error)
+
+	// Add DNS row with records mapping hostnames to space-separated IPs, ExecuteR returns its UUID
+	DNSAdd(records map[string]string, external_ids map[string]string) (*OvnCommand, error)
+	// Delete DNS row by UUID and remove it from logical switches
+	DNSDel(uuid string) (*OvnCommand, error)
+	// Get DNS row by UUID
+	DNSGet(uuid string) (*DNS, error)
+	// List all DNS rows
+	DNSList() ([]*DNS, error)
+	// Set the IPs of hostname in DNS row, replacing existing ones
+	DNSSetRecord(uuid, hostname string, ips ...string) (*OvnCommand, error)
+	// Remove hostname from DNS row
+	DNSRemoveRecord(uuid, hostname string) (*OvnCommand, error)
+	// Add DNS row to logical switch
+	LSDNSAdd(ls string, uuid string) (*OvnCommand, error)
+	// Remove DNS row from logical switch
+	LSDNSDel(ls string, uuid string) (*OvnCommand, error)
+	// List DNS rows of logical switch
+	LSDNSList(ls string) ([]*DNS, error)
+
+	// Set the gateway chassis of lrp on chassis with priority, the priority is updated if it exists
+	LRPSetGatewayChassis(lrp, chassis string, priority int) (*OvnCommand, error)
+	// Delete the gateway chassis of lrp on chassis
+	LRPDelGatewayChassis(lrp, chassis string) (*OvnCommand, error)
+	// List the gateway chassis of lrp ordered by descending priority
+	LRPGatewayChassisList(lrp string) ([]*GatewayChassis, error)
+	// Set the HA chassis group of lrp, an empty group clears it
+	LRPSetHAChassisGroup(lrp, group string) (*OvnCommand, error)
+
+	// Add HA chassis group
+	HAChassisGroupAdd(group string, external_ids map[string]string) (*OvnCommand, error)
+	// Delete HA chassis group
+	HAChassisGroupDel(group string) (*OvnCommand, error)
+	// Get HA chassis group with its HA chassis ordered by descending priority
+	HAChassisGroupGet(group string) (*HAChassisGroup, error)
+	// List HA chassis groups
+	HAChassisGroupList() ([]*HAChassisGroup, error)
+	// Add chassis to HA chassis group with priority, the priority is updated if it exists
+	HAChassisGroupAddChassis(group, chassis string, priority int) (*OvnCommand, error)
+	// Remove chassis from HA chassis group
+	HAChassisGroupRemoveChassis(group, chassis string) (*OvnCommand, error)
+
 	// Set NB_Global table options
 	NBGlobalSetOptions(options map[string]string) (*OvnCommand, error)
 
@@ -249,6 +317,79 @@ type Client interface {
 	// Get SB_Global table options
 	SBGlobalGetOptions() (map[string]string, error)
 
+	// Get logical switch by name, returns ctx.Err() if ctx is done while the cache is locked
+	LSGetContext(ctx context.Context, ls string) ([]*LogicalSwitch, error)
+	// List logical switches, returns ctx.Err() if ctx is done while the cache is locked
+	LSListContext(ctx context.Context) ([]*LogicalSwitch, error)
+	// Get logical switch port by name, returns ctx.Err() if ctx is done while the cache is locked
+	LSPGetContext(ctx context.Context, lsp string) (*LogicalSwitchPort, error)
+	// List logical switch ports of ls, returns ctx.Err() if ctx is done while the cache is locked
+	LSPListContext(ctx context.Context, ls string) ([]*LogicalSwitchPort, error)
+	// List ACLs of ls, returns ctx.Err() if ctx is done while the cache is locked
+	ACLListContext(ctx context.Context, ls string) ([]*ACL, error)
+	// Get address set by name, returns ctx.Err() if ctx is done while the cache is locked
+	ASGetContext(ctx context.Context, name string) (*AddressSet, error)
+	// List address sets, returns ctx.Err() if ctx is done while the cache is locked
+	ASListContext(ctx context.Context) ([]*AddressSet, error)
+	// Get logical router by name, returns ctx.Err() if ctx is done while the cache is locked
+	LRGetContext(ctx context.Context, name string) ([]*LogicalRouter, error)
+	// List logical routers, returns ctx.Err() if ctx is done while the cache is locked
+	LRListContext(ctx context.Context) ([]*LogicalRouter, error)
+	// List logical router ports of lr, returns ctx.Err() if ctx is done while the cache is locked
+	LRPListContext(ctx context.Context, lr string) ([]*LogicalRouterPort, error)
+	// Get load balancer by name, returns ctx.Err() if ctx is done while the cache is locked
+	LBGetContext(ctx context.Context, name string) ([]*LoadBalancer, error)
+	// List load balancers, returns ctx.Err() if ctx is done while the cache is locked
+	LBListContext(ctx context.Context) ([]*LoadBalancer, error)
+	// Get port group by name, returns ctx.Err() if ctx is done while the cache is locked
+	PortGroupGetContext(ctx context.Context, group string) (*PortGroup, error)
+	// Get chassis by hostname or name, returns ctx.Err() if ctx is done while the cache is locked
+	ChassisGetContext(ctx context.Context, name string) ([]*Chassis, error)
+	// List chassis, returns ctx.Err() if ctx is done while the cache is locked
+	ChassisListContext(ctx context.Context) ([]*Chassis, error)
+
+	// Get NB_Global table row
+	NBGlobalGet() (*NBGlobalTableRow, error)
+	// Get SB_Global table row
+	SBGlobalGet() (*SBGlobalTableRow, error)
+	// Execute cmds and wait until the change is applied to level, like ovn-nbctl --wait.
+	// ErrorSchema is returned if the NB_Global column of level is not monitored.
+	WaitForSync(ctx context.Context, level SyncLevel, cmds ...*OvnCommand) error
+
+	// Fill model, a pointer to a struct of Config.Models, with the row with uuid
+	ModelGet(uuid string, model interface{}) error
+	// Fill result, a pointer to a slice of pointers to a struct of Config.Models, with all rows of its table
+	ModelList(result interface{}) error
+	// Insert the row of model, ExecuteR returns its UUID
+	ModelAdd(model interface{}) (*OvnCommand, error)
+	// Update the columns, all if none given, of the row with the UUID of model
+	ModelUpdate(model interface{}, columns ...string) (*OvnCommand, error)
+	// Delete the row with the UUID of model
+	ModelDel(model interface{}) (*OvnCommand, error)
+
+	// Make the logical switches, routers and port groups owned by desired.Owner, i.e. with external_ids:ownerKey=desired.Owner, match desired
+	Reconcile(ctx context.Context, desired *Topology, ownerKey string) (*ReconcileReport, error)
+	// Report the changes Reconcile would make without making them
+	ReconcileDryRun(desired *Topology, ownerKey string) (*ReconcileReport, error)
+
+	// Get a copy of the monitored tables with UUIDs replaced by symbolic row names
+	Snapshot() (*Snapshot, error)
+	// Write the rows of snapshot into the empty tables of the database, batchSize rows per transaction or DefaultImportBatchSize if 0
+	Import(ctx context.Context, snapshot *Snapshot, batchSize int) error
+
+	// Replace the connections of NB_Global or SB_Global with conns
+	ConnectionSet(conns ...*Connection) (*OvnCommand, error)
+	// Remove all connections of NB_Global or SB_Global
+	ConnectionDel() (*OvnCommand, error)
+	// Get the connections of NB_Global or SB_Global, ErrorNotFound if it has no row
+	ConnectionGet() ([]*Connection, error)
+	// Replace the SSL configuration of NB_Global or SB_Global
+	SSLSet(ssl *SSL) (*OvnCommand, error)
+	// Remove the SSL configuration of NB_Global or SB_Global
+	SSLDel() (*OvnCommand, error)
+	// Get the SSL configuration of NB_Global or SB_Global, ErrorNotFound if there is none
+	SSLGet() (*SSL, error)
+
 	// Creates a new port group in the Port_Group table named "group" with optional "ports"  and "external_ids".
 	PortGroupAdd(group string, ports []string, external_ids map[string]string) (*OvnCommand, error)
 	// Sets "ports" and/or "external_ids" on the port group named "group". It is an error if group does not exist.
@@ -262,8 +403,17 @@ type Client interface {
 	// Get PortGroup data structure if it exists
 	PortGroupGet(group string) (*PortGroup, error)
 
+	// Register handler for the add, update and delete events of the rows of
+	// a monitored table, or of all monitored tables if table is empty.
+	// A nil filter passes all events.
+	AddEventHandler(table string, filter EventFilter, handler EventHandler) (EventHandlerID, error)
+	// Unregister an event handler
+	RemoveEventHandler(id EventHandlerID) error
+
 	// Close connection to OVN
 	Close() error
+	// Connected reports whether the client is connected to the db
+	Connected() bool
 
 	// GetSchema() returns ovn-db schema
 	GetSchema() libovsdb.DatabaseSchema
@@ -277,21 +427,47 @@ type Client interface {
 
 var _ Client = &ovndb{}
 
+// ovndbConfig is the configuration of a client, set by NewClient and shared
+// with the cache overlays of its transactions
+type ovndbConfig struct {
+	signalCB        OVNSignal
+	disconnectCB    OVNDisconnectedCallback
+	db              string
+	addr            string
+	endpoints       []string
+	leaderOnly      bool
+	tableCols       map[string][]string
+	tlsConfig       *tls.Config
+	reconn          bool
+	reconnectPolicy ReconnectPolicy
+	reconnectingCB  OVNReconnectingCallback
+	reconnectedCB   OVNReconnectedCallback
+	timeout         time.Duration
+	logger          Logger
+	debugLogger     Logger
+	metrics         Metrics
+	modelDefs       map[string]interface{}
+}
+
 type ovndb struct {
-	client       *libovsdb.OvsdbClient
-	cache        map[string]map[string]libovsdb.Row
-	cachemutex   sync.RWMutex
-	signalCB     OVNSignal
-	disconnectCB OVNDisconnectedCallback
-	db           string
-	addr         string
-	tableCols    map[string][]string
-	tlsConfig    *tls.Config
-	reconn       bool
+	ovndbConfig
+	client        *libovsdb.OvsdbClient
+	cache         map[string]map[string]libovsdb.Row
+	cachemutex    sync.RWMutex
+	endpoint      int
+	populated     map[string]PopulatedColumns
+	connected     int32
+	closed        int32
+	models        map[reflect.Type]string
+	eventHandlers eventHandlers
+	events        *eventQueue
+	transactFunc  atomic.Value // transact.Func
+	// txnReads is only set on the cache overlay of a Transaction
+	txnReads *txnReadSet
 }
 
 func connect(c *ovndb) (err error) {
-	ovsdb, err := libovsdb.Connect(c.addr, c.tlsConfig)
+	ovsdb, err := c.dialEndpoint()
 	if err != nil {
 		return err
 	}
@@ -303,6 +479,9 @@ func connect(c *ovndb) (err error) {
 		}
 	}()
 
+	// The events of the initial dump are delivered once the cache is unlocked
+	defer c.events.flush()
+
 	// Locking the cache mutex to ensure the cache is filled before
 	// events from the notifier are handled.
 	c.cachemutex.Lock()
@@ -320,13 +499,25 @@ func connect(c *ovndb) (err error) {
 	if err != nil {
 		return err
 	}
+	if c.leaderOnly {
+		if err = c.monitorLeader(); err != nil {
+			return err
+		}
+	}
 
 	// We do the initial dump and populate the cache, we have the mutex
-	c.populateCache(*initial)
+	c.events.stage(c.populateCache(*initial))
+	c.setConnected(true)
+	c.logger.Info("connected", "endpoint", c.endpoints[c.endpoint], "db", c.db)
 	return nil
 }
 
 func NewClient(cfg *Config) (Client, error) {
+	return NewClientContext(context.Background(), cfg)
+}
+
+// NewClientContext creates a client, it gives up connecting when ctx is done
+func NewClientContext(ctx context.Context, cfg *Config) (Client, error) {
 	db := cfg.Db
 	// db string should strictly be OVN_Northbound or OVN_Southbound
 	switch db {
@@ -338,59 +529,53 @@ func NewClient(cfg *Config) (Client, error) {
 		return nil, fmt.Errorf("Valid db names are: %s and %s", DBNB, DBSB)
 	}
 
+	logger := cfg.Logger
+	if logger == nil {
+		logger = stdLogger{}
+	}
 	ovndb := &ovndb{
-		signalCB:     cfg.SignalCB,
-		disconnectCB: cfg.DisconnectCB,
-		db:           db,
-		tableCols:    cfg.TableCols,
-		addr:         cfg.Addr,
-		tlsConfig:    cfg.TLSConfig,
-		reconn:       cfg.Reconnect,
+		ovndbConfig: ovndbConfig{
+			signalCB:        cfg.SignalCB,
+			disconnectCB:    cfg.DisconnectCB,
+			db:              db,
+			tableCols:       cfg.TableCols,
+			addr:            cfg.Addr,
+			endpoints:       splitEndpoints(cfg.Addr),
+			leaderOnly:      cfg.LeaderOnly,
+			tlsConfig:       cfg.TLSConfig,
+			reconn:          cfg.Reconnect || cfg.LeaderOnly,
+			reconnectPolicy: cfg.ReconnectPolicy,
+			reconnectingCB:  cfg.OnReconnecting,
+			reconnectedCB:   cfg.OnReconnected,
+			timeout:         cfg.Timeout,
+			logger:          logger,
+			debugLogger:     cfg.DebugLogger,
+			metrics:         cfg.Metrics,
+			modelDefs:       cfg.Models,
+		},
+		endpoint: -1,
+		events:   newEventQueue(cfg.EventQueueDepth, cfg.EventQueueOverflow, logger),
 	}
+	go ovndb.dispatch()
 
-	err := connect(ovndb)
+	err := connectContext(ctx, ovndb)
 	if err != nil {
+		ovndb.events.close()
 		return nil, err
 	}
+	if err = ovndb.registerModels(); err != nil {
+		ovndb.Close()
+		return nil, err
+	}
+	if cfg.OnConnected != nil {
+		cfg.OnConnected()
+	}
 	return ovndb, err
 }
 
-func (c *ovndb) reconnect() {
-	ticker := time.NewTicker(500 * time.Millisecond)
-	go func() {
-		log.Printf("%s disconnected. Reconnecting ... \n", c.addr)
-		retry := 0
-		for range ticker.C {
-			if err := connect(c); err != nil {
-				if retry < 10 {
-					log.Printf("%s reconnect failed (%v). Retry...\n",
-						c.addr, err)
-				} else if retry == 10 {
-					log.Printf("%s reconnect failed (%v). Continue retrying but log will be supressed.\n",
-						c.addr, err)
-				}
-				retry++
-				continue
-			}
-			log.Printf("%s reconnected after %d retries.\n", c.addr, retry)
-			ticker.Stop()
-			return
-		}
-	}()
-}
-
-// filterTablesFromSchema checks whether tables in
-// NBTablesOrder / SBTablesOrder exists in current ovn-db schema
-func (c *ovndb) filterTablesFromSchema() []string {
-	var tables []string
-
-	// get the table list based on the DB
-	if c.db == DBNB {
-		tables = NBTablesOrder
-	} else {
-		tables = SBTablesOrder
-	}
-
+// filterTablesFromSchema returns the tables that exist in the current
+// ovn-db schema
+func (c *ovndb) filterTablesFromSchema(tables []string) []string {
 	dbSchema := c.GetSchema()
 	schemaTables := make([]string, 0)
 	for _, table := range tables {
@@ -402,22 +587,26 @@ func (c *ovndb) filterTablesFromSchema() []string {
 }
 
 func (c *ovndb) MonitorTables(jsonContext interface{}) (*libovsdb.TableUpdates, error) {
-	tables := c.filterTablesFromSchema()
+	// get the table list based on the DB
+	var tables, optIn []string
+	if c.db == DBNB {
+		tables = NBTablesOrder
+	} else {
+		tables = SBTablesOrder
+		optIn = SBOptInTables
+	}
+	tables = c.filterTablesFromSchema(tables)
 	// verify whether user specified table and its columns are legit
 	if len(c.tableCols) != 0 {
 		supportedTableMaps := make(map[string]bool)
-		for _, table := range tables {
+		for _, table := range append(tables, c.filterTablesFromSchema(optIn)...) {
+			supportedTableMaps[table] = true
+		}
+		for table := range c.modelDefs {
 			supportedTableMaps[table] = true
 		}
-		for table, columns := range c.tableCols {
-			if _, ok := supportedTableMaps[table]; ok {
-				// TODO: adding support for specific columns requires more work.
-				// All of the rowTo<TableName>() functions need to be fixed for
-				// the missing columns.
-				if len(columns) != 0 {
-					return nil, fmt.Errorf("providing specific columns is not supported yet")
-				}
-			} else {
+		for table := range c.tableCols {
+			if _, ok := supportedTableMaps[table]; !ok {
 				return nil, fmt.Errorf("specified table %q in database %q not supported by the library",
 					table, c.db)
 			}
@@ -427,7 +616,19 @@ func (c *ovndb) MonitorTables(jsonContext interface{}) (*libovsdb.TableUpdates,
 		for _, table := range tables {
 			c.tableCols[table] = []string{}
 		}
+		// the tables of models are monitored even without an API for them
+		for table := range c.modelDefs {
+			if _, ok := c.GetSchema().Tables[table]; ok {
+				c.tableCols[table] = []string{}
+			}
+		}
 	}
+	populated, err := populatedColumns(c.GetSchema(), c.tableCols)
+	if err != nil {
+		return nil, err
+	}
+	c.populated = populated
+
 	requests := make(map[string]libovsdb.MonitorRequest)
 	for table, columns := range c.tableCols {
 		requests[table] = libovsdb.MonitorRequest{
@@ -444,6 +645,8 @@ func (c *ovndb) MonitorTables(jsonContext interface{}) (*libovsdb.TableUpdates,
 
 // TODO return proper error
 func (c *ovndb) Close() error {
+	atomic.StoreInt32(&c.closed, 1)
+	c.events.close()
 	c.client.Disconnect()
 	return nil
 }
@@ -456,6 +659,34 @@ func (c *ovndb) EncapList(chname string) ([]*Encap, error) {
 	return c.encapListImp(chname)
 }
 
+func (c *ovndb) PortBindingList() ([]*PortBinding, error) {
+	return c.portBindingListImp()
+}
+
+func (c *ovndb) PortBindingGet(uuid string) (*PortBinding, error) {
+	return c.portBindingGetImp(uuid)
+}
+
+func (c *ovndb) PortBindingGetByLogicalPort(lport string) (*PortBinding, error) {
+	return c.portBindingGetByLogicalPortImp(lport)
+}
+
+func (c *ovndb) PortBindingListByChassis(chassis string) ([]*PortBinding, error) {
+	return c.portBindingListByChassisImp(chassis)
+}
+
+func (c *ovndb) DatapathBindingList() ([]*DatapathBinding, error) {
+	return c.datapathBindingListImp()
+}
+
+func (c *ovndb) DatapathBindingGet(name string) ([]*DatapathBinding, error) {
+	return c.datapathBindingGetImp(name)
+}
+
+func (c *ovndb) LogicalFlowList(datapath, pipeline string, tableID *int, stageName string) ([]*LogicalFlow, error) {
+	return c.logicalFlowListImp(datapath, pipeline, tableID, stageName)
+}
+
 func (c *ovndb) ChassisGet(name string) ([]*Chassis, error) {
 	return c.chassisGetImp(name)
 }
@@ -741,6 +972,19 @@ func (c *ovndb) ExecuteR(cmds ...*OvnCommand) ([]string, error) {
 	return c.executeR(cmds...)
 }
 
+func (c *ovndb) ExecuteContext(ctx context.Context, cmds ...*OvnCommand) error {
+	_, err := c.executeRContext(ctx, cmds...)
+	return err
+}
+
+func (c *ovndb) ExecuteRContext(ctx context.Context, cmds ...*OvnCommand) ([]string, error) {
+	return c.executeRContext(ctx, cmds...)
+}
+
+func (c *ovndb) NewTransaction() *Transaction {
+	return c.newTransaction()
+}
+
 func (c *ovndb) LSGet(ls string) ([]*LogicalSwitch, error) {
 	return c.lsGetImp(ls)
 }
@@ -886,3 +1130,253 @@ func (c *ovndb) AuxKeyValSet(table string, rowName string, auxCol string, kv map
 func (c *ovndb) AuxKeyValDel(table string, rowName string, auxCol string, kv map[string]*string) (*OvnCommand, error) {
 	return c.auxKeyValDel(table, rowName, auxCol, kv)
 }
+
+func (c *ovndb) AddEventHandler(table string, filter EventFilter, handler EventHandler) (EventHandlerID, error) {
+	return c.addEventHandlerImp(table, filter, handler)
+}
+
+func (c *ovndb) RemoveEventHandler(id EventHandlerID) error {
+	return c.removeEventHandlerImp(id)
+}
+
+func (c *ovndb) Connected() bool {
+	return atomic.LoadInt32(&c.connected) == 1
+}
+
+func (c *ovndb) LRPSetGatewayChassis(lrp, chassis string, priority int) (*OvnCommand, error) {
+	return c.lrpSetGatewayChassisImp(lrp, chassis, priority)
+}
+
+func (c *ovndb) LRPDelGatewayChassis(lrp, chassis string) (*OvnCommand, error) {
+	return c.lrpDelGatewayChassisImp(lrp, chassis)
+}
+
+func (c *ovndb) LRPGatewayChassisList(lrp string) ([]*GatewayChassis, error) {
+	return c.lrpGatewayChassisListImp(lrp)
+}
+
+func (c *ovndb) LRPSetHAChassisGroup(lrp, group string) (*OvnCommand, error) {
+	return c.lrpSetHAChassisGroupImp(lrp, group)
+}
+
+func (c *ovndb) HAChassisGroupAdd(group string, external_ids map[string]string) (*OvnCommand, error) {
+	return c.haChassisGroupAddImp(group, external_ids)
+}
+
+func (c *ovndb) HAChassisGroupDel(group string) (*OvnCommand, error) {
+	return c.haChassisGroupDelImp(group)
+}
+
+func (c *ovndb) HAChassisGroupGet(group string) (*HAChassisGroup, error) {
+	return c.haChassisGroupGetImp(group)
+}
+
+func (c *ovndb) HAChassisGroupList() ([]*HAChassisGroup, error) {
+	return c.haChassisGroupListImp()
+}
+
+func (c *ovndb) HAChassisGroupAddChassis(group, chassis string, priority int) (*OvnCommand, error) {
+	return c.haChassisGroupAddChassisImp(group, chassis, priority)
+}
+
+func (c *ovndb) HAChassisGroupRemoveChassis(group, chassis string) (*OvnCommand, error) {
+	return c.haChassisGroupRemoveChassisImp(group, chassis)
+}
+
+func (c *ovndb) DNSAdd(records map[string]string, external_ids map[string]string) (*OvnCommand, error) {
+	return c.dnsAddImp(records, external_ids)
+}
+
+func (c *ovndb) DNSDel(uuid string) (*OvnCommand, error) {
+	return c.dnsDelImp(uuid)
+}
+
+func (c *ovndb) DNSGet(uuid string) (*DNS, error) {
+	return c.dnsGetImp(uuid)
+}
+
+func (c *ovndb) DNSList() ([]*DNS, error) {
+	return c.dnsListImp()
+}
+
+func (c *ovndb) DNSSetRecord(uuid, hostname string, ips ...string) (*OvnCommand, error) {
+	return c.dnsSetRecordImp(uuid, hostname, ips...)
+}
+
+func (c *ovndb) DNSRemoveRecord(uuid, hostname string) (*OvnCommand, error) {
+	return c.dnsRemoveRecordImp(uuid, hostname)
+}
+
+func (c *ovndb) LSDNSAdd(ls string, uuid string) (*OvnCommand, error) {
+	return c.lsDNSAddImp(ls, uuid)
+}
+
+func (c *ovndb) LSDNSDel(ls string, uuid string) (*OvnCommand, error) {
+	return c.lsDNSDelImp(ls, uuid)
+}
+
+func (c *ovndb) LSDNSList(ls string) ([]*DNS, error) {
+	return c.lsDNSListImp(ls)
+}
+
+func (c *ovndb) ConnectionSet(conns ...*Connection) (*OvnCommand, error) {
+	return c.connectionSetImp(conns...)
+}
+
+func (c *ovndb) ConnectionDel() (*OvnCommand, error) {
+	return c.connectionDelImp()
+}
+
+func (c *ovndb) ConnectionGet() ([]*Connection, error) {
+	return c.connectionGetImp()
+}
+
+func (c *ovndb) SSLSet(ssl *SSL) (*OvnCommand, error) {
+	return c.sslSetImp(ssl)
+}
+
+func (c *ovndb) SSLDel() (*OvnCommand, error) {
+	return c.sslDelImp()
+}
+
+func (c *ovndb) SSLGet() (*SSL, error) {
+	return c.sslGetImp()
+}
+
+func (c *ovndb) NBGlobalGet() (*NBGlobalTableRow, error) {
+	return c.nbGlobalGetImp()
+}
+
+func (c *ovndb) SBGlobalGet() (*SBGlobalTableRow, error) {
+	return c.sbGlobalGetImp()
+}
+
+func (c *ovndb) WaitForSync(ctx context.Context, level SyncLevel, cmds ...*OvnCommand) error {
+	return c.waitForSyncImp(ctx, level, cmds...)
+}
+
+func (c *ovndb) LSGetContext(ctx context.Context, ls string) ([]*LogicalSwitch, error) {
+	res, err := readContext(ctx, func() (interface{}, error) { return c.lsGetImp(ls) })
+	v, _ := res.([]*LogicalSwitch)
+	return v, err
+}
+
+func (c *ovndb) LSListContext(ctx context.Context) ([]*LogicalSwitch, error) {
+	res, err := readContext(ctx, func() (interface{}, error) { return c.lsListImp() })
+	v, _ := res.([]*LogicalSwitch)
+	return v, err
+}
+
+func (c *ovndb) LSPGetContext(ctx context.Context, lsp string) (*LogicalSwitchPort, error) {
+	res, err := readContext(ctx, func() (interface{}, error) { return c.lspGetImp(lsp) })
+	v, _ := res.(*LogicalSwitchPort)
+	return v, err
+}
+
+func (c *ovndb) LSPListContext(ctx context.Context, ls string) ([]*LogicalSwitchPort, error) {
+	res, err := readContext(ctx, func() (interface{}, error) { return c.lspListImp(ls) })
+	v, _ := res.([]*LogicalSwitchPort)
+	return v, err
+}
+
+func (c *ovndb) ACLListContext(ctx context.Context, ls string) ([]*ACL, error) {
+	res, err := readContext(ctx, func() (interface{}, error) { return c.aclListImp(LOGICAL_SWITCH, ls) })
+	v, _ := res.([]*ACL)
+	return v, err
+}
+
+func (c *ovndb) ASGetContext(ctx context.Context, name string) (*AddressSet, error) {
+	res, err := readContext(ctx, func() (interface{}, error) { return c.asGetImp(name) })
+	v, _ := res.(*AddressSet)
+	return v, err
+}
+
+func (c *ovndb) ASListContext(ctx context.Context) ([]*AddressSet, error) {
+	res, err := readContext(ctx, func() (interface{}, error) { return c.asListImp() })
+	v, _ := res.([]*AddressSet)
+	return v, err
+}
+
+func (c *ovndb) LRGetContext(ctx context.Context, name string) ([]*LogicalRouter, error) {
+	res, err := readContext(ctx, func() (interface{}, error) { return c.lrGetImp(name) })
+	v, _ := res.([]*LogicalRouter)
+	return v, err
+}
+
+func (c *ovndb) LRListContext(ctx context.Context) ([]*LogicalRouter, error) {
+	res, err := readContext(ctx, func() (interface{}, error) { return c.lrListImp() })
+	v, _ := res.([]*LogicalRouter)
+	return v, err
+}
+
+func (c *ovndb) LRPListContext(ctx context.Context, lr string) ([]*LogicalRouterPort, error) {
+	res, err := readContext(ctx, func() (interface{}, error) { return c.lrpListImp(lr) })
+	v, _ := res.([]*LogicalRouterPort)
+	return v, err
+}
+
+func (c *ovndb) LBGetContext(ctx context.Context, name string) ([]*LoadBalancer, error) {
+	res, err := readContext(ctx, func() (interface{}, error) { return c.lbGetImp(name) })
+	v, _ := res.([]*LoadBalancer)
+	return v, err
+}
+
+func (c *ovndb) LBListContext(ctx context.Context) ([]*LoadBalancer, error) {
+	res, err := readContext(ctx, func() (interface{}, error) { return c.lbListImp() })
+	v, _ := res.([]*LoadBalancer)
+	return v, err
+}
+
+func (c *ovndb) PortGroupGetContext(ctx context.Context, group string) (*PortGroup, error) {
+	res, err := readContext(ctx, func() (interface{}, error) { return c.pgGetImp(group) })
+	v, _ := res.(*PortGroup)
+	return v, err
+}
+
+func (c *ovndb) ChassisGetContext(ctx context.Context, name string) ([]*Chassis, error) {
+	res, err := readContext(ctx, func() (interface{}, error) { return c.chassisGetImp(name) })
+	v, _ := res.([]*Chassis)
+	return v, err
+}
+
+func (c *ovndb) ChassisListContext(ctx context.Context) ([]*Chassis, error) {
+	res, err := readContext(ctx, func() (interface{}, error) { return c.chassisListImp() })
+	v, _ := res.([]*Chassis)
+	return v, err
+}
+
+func (c *ovndb) ModelGet(uuid string, model interface{}) error {
+	return c.modelGetImp(uuid, model)
+}
+
+func (c *ovndb) ModelList(result interface{}) error {
+	return c.modelListImp(result)
+}
+
+func (c *ovndb) ModelAdd(model interface{}) (*OvnCommand, error) {
+	return c.modelAddImp(model)
+}
+
+func (c *ovndb) ModelUpdate(model interface{}, columns ...string) (*OvnCommand, error) {
+	return c.modelUpdateImp(model, columns...)
+}
+
+func (c *ovndb) ModelDel(model interface{}) (*OvnCommand, error) {
+	return c.modelDelImp(model)
+}
+
+func (c *ovndb) Reconcile(ctx context.Context, desired *Topology, ownerKey string) (*ReconcileReport, error) {
+	return c.reconcileImp(ctx, desired, ownerKey)
+}
+
+func (c *ovndb) ReconcileDryRun(desired *Topology, ownerKey string) (*ReconcileReport, error) {
+	return c.reconcileDryRunImp(desired, ownerKey)
+}
+
+func (c *ovndb) Snapshot() (*Snapshot, error) {
+	return c.snapshotImp()
+}
+
+func (c *ovndb) Import(ctx context.Context, snapshot *Snapshot, batchSize int) error {
+	return c.importImp(ctx, snapshot, batchSize)
+}
@@ -141,3 +141,67 @@ func TestNewClient_ValidSBTableInvalidCol(t *testing.T) {
 	assert.Error(t, err)
 	t.Log(err.Error())
 }
+
+func TestNewClient_NBTableColumns(t *testing.T) {
+	cfg := buildOvnDbConfig(DBNB)
+	cfg.TableCols = map[string][]string{
+		TableLogicalSwitch:     {"name", "ports"},
+		TableLogicalSwitchPort: {"name", "addresses", "external_ids"},
+	}
+	api, err := NewClient(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer api.Close()
+
+	cmd, err := api.LSAdd(LS3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = api.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmd, err = api.LSPAdd(LS3, LSP)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = api.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmd, err = api.LSPSetAddress(LSP, ADDR)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = api.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lsp, err := api.LSPGet(LSP)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, LSP, lsp.Name)
+	assert.Equal(t, []string{ADDR}, lsp.Addresses)
+	assert.True(t, lsp.IsPopulated("addresses"))
+	assert.False(t, lsp.IsPopulated("options"))
+	assert.Nil(t, lsp.Options)
+
+	ls, err := api.LSGet(LS3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 1, len(ls))
+	assert.False(t, ls[0].IsPopulated("external_ids"))
+
+	cmd, err = api.LSDel(LS3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = api.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,139 @@
+/**
+ * Copyright (c) 2020 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/ebay/libovsdb"
+)
+
+// serverMonitorContext is the json-value of the monitor of the _Server db,
+// it tells its updates apart from those of the OVN db
+const serverMonitorContext = "leader"
+
+// splitEndpoints splits a comma-separated list of remotes as accepted by
+// ovn-nbctl --db and shuffles it, so that clients of a cluster spread over
+// its servers.
+func splitEndpoints(addr string) []string {
+	var endpoints []string
+	for _, endpoint := range strings.Split(addr, ",") {
+		if endpoint = strings.TrimSpace(endpoint); endpoint != "" {
+			endpoints = append(endpoints, endpoint)
+		}
+	}
+	rnd := rand.New(rand.NewSource(time.Now().UnixNano()))
+	rnd.Shuffle(len(endpoints), func(i, j int) {
+		endpoints[i], endpoints[j] = endpoints[j], endpoints[i]
+	})
+	return endpoints
+}
+
+// dialEndpoint connects to the next endpoint that accepts the connection,
+// starting after the endpoint of the previous connection so that a failed
+// server is tried last. With leaderOnly, servers that are not the leader of
+// the db are skipped.
+func (c *ovndb) dialEndpoint() (*libovsdb.OvsdbClient, error) {
+	if len(c.endpoints) == 0 {
+		return nil, fmt.Errorf("no endpoint in %q", c.addr)
+	}
+	var err error
+	for i := 0; i < len(c.endpoints); i++ {
+		c.endpoint = (c.endpoint + 1) % len(c.endpoints)
+		endpoint := c.endpoints[c.endpoint]
+
+		ovsdb, e := libovsdb.Connect(endpoint, c.tlsConfig)
+		if e != nil {
+			err = e
+			continue
+		}
+		if c.leaderOnly {
+			leader, e := isLeader(ovsdb, c.db)
+			if e == nil && !leader {
+				e = fmt.Errorf("%s is not the leader of %s", endpoint, c.db)
+			}
+			if e != nil {
+				ovsdb.Disconnect()
+				err = e
+				continue
+			}
+		}
+		return ovsdb, nil
+	}
+	return nil, err
+}
+
+// isLeader checks the _Server db of the server whether it is the leader of
+// db. Databases that are not clustered always report to be the leader.
+func isLeader(ovsdb *libovsdb.OvsdbClient, db string) (bool, error) {
+	if _, ok := ovsdb.Schema[DBServer]; !ok {
+		return false, fmt.Errorf("server has no %s database", DBServer)
+	}
+	selectOp := libovsdb.Operation{
+		Op:      opSelect,
+		Table:   TableServerDatabase,
+		Where:   []interface{}{libovsdb.NewCondition("name", "==", db)},
+		Columns: []string{"name", "leader"},
+	}
+	reply, err := ovsdb.Transact(DBServer, selectOp)
+	if err != nil {
+		return false, err
+	}
+	if len(reply) != 1 || reply[0].Error != "" {
+		return false, fmt.Errorf("select of %s failed: %v", DBServer, reply)
+	}
+	if len(reply[0].Rows) != 1 {
+		return false, fmt.Errorf("database %s not found in %s", db, DBServer)
+	}
+	leader, _ := reply[0].Rows[0]["leader"].(bool)
+	return leader, nil
+}
+
+// monitorLeader monitors the _Server db so that the client disconnects and
+// reconnects to the new leader when the server loses leadership.
+func (c *ovndb) monitorLeader() error {
+	requests := map[string]libovsdb.MonitorRequest{
+		TableServerDatabase: {
+			Columns: []string{"name", "leader"},
+			Select: libovsdb.MonitorSelect{
+				Insert: true,
+				Modify: true,
+			},
+		},
+	}
+	_, err := c.client.Monitor(DBServer, serverMonitorContext, requests)
+	return err
+}
+
+// checkLeader handles the updates of the _Server monitor
+func (c *ovndb) checkLeader(updates libovsdb.TableUpdates) {
+	for _, row := range updates.Updates[TableServerDatabase].Rows {
+		if name, _ := row.New.Fields["name"].(string); name != c.db {
+			continue
+		}
+		if leader, ok := row.New.Fields["leader"].(bool); ok && !leader {
+			c.logger.Info("lost leadership, disconnecting", "endpoint", c.endpoints[c.endpoint], "db", c.db)
+			// the update is handled by the libovsdb reader, which must
+			// not wait for the disconnection
+			go c.client.Disconnect()
+			return
+		}
+	}
+}
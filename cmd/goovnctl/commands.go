@@ -0,0 +1,802 @@
+/**
+ * Copyright (c) 2020 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	goovn "github.com/ebay/go-ovn"
+)
+
+// command is a goovnctl command
+type command struct {
+	name string
+	args string // usage of the arguments
+	db   string
+	min  int
+	max  int // -1 for any number of arguments
+	// options accepted before the command name, those that take a value
+	// end with "="
+	options []string
+	run     func(c goovn.Client, inv *invocation) (*goovn.OvnCommand, error)
+}
+
+// invocation is a command with its arguments and options
+type invocation struct {
+	cmd    *command
+	args   []string
+	opts   map[string]string
+	output *table // set by commands with output
+}
+
+func (inv *invocation) String() string {
+	return strings.Join(append([]string{inv.cmd.name}, inv.args...), " ")
+}
+
+func (inv *invocation) has(option string) bool {
+	_, ok := inv.opts[option]
+	return ok
+}
+
+// arg returns the ith argument, "" if it was not given
+func (inv *invocation) arg(i int) string {
+	if i < len(inv.args) {
+		return inv.args[i]
+	}
+	return ""
+}
+
+// build is the goovn.TxnBuilder of the invocation. It is run again if the
+// transaction is retried, so commands only build their command and output.
+func (inv *invocation) build(c goovn.Client) (*goovn.OvnCommand, error) {
+	cmd, err := inv.cmd.run(c, inv)
+	switch {
+	case err == goovn.ErrorExist && inv.has("may-exist"):
+		return nil, nil
+	case err == goovn.ErrorNotFound && inv.has("if-exists"):
+		return nil, nil
+	}
+	return cmd, err
+}
+
+// parseCommands parses the commands separated by "--", each preceded by
+// its options
+func parseCommands(args []string) ([]*invocation, error) {
+	var invs []*invocation
+	start := 0
+	for i := 0; i <= len(args); i++ {
+		if i < len(args) && args[i] != "--" {
+			continue
+		}
+		inv, err := parseCommand(args[start:i])
+		if err != nil {
+			return nil, err
+		}
+		invs = append(invs, inv)
+		start = i + 1
+	}
+	for _, inv := range invs[1:] {
+		if inv.cmd.db != invs[0].cmd.db {
+			return nil, errors.New("northbound and southbound commands cannot be combined")
+		}
+	}
+	return invs, nil
+}
+
+func parseCommand(args []string) (*invocation, error) {
+	opts := make(map[string]string)
+	for len(args) > 0 && strings.HasPrefix(args[0], "--") {
+		opt := strings.TrimPrefix(args[0], "--")
+		value := ""
+		if i := strings.Index(opt, "="); i >= 0 {
+			opt, value = opt[:i], opt[i+1:]
+		}
+		opts[opt] = value
+		args = args[1:]
+	}
+	if len(args) == 0 {
+		return nil, errors.New("missing command name")
+	}
+	cmd, ok := commandsByName[args[0]]
+	if !ok {
+		return nil, fmt.Errorf("unknown command %q", args[0])
+	}
+	inv := &invocation{cmd: cmd, args: args[1:], opts: opts}
+	for opt, value := range opts {
+		if !cmd.accepts(opt, value != "") {
+			return nil, fmt.Errorf("%s: invalid option --%s", cmd.name, opt)
+		}
+	}
+	if len(inv.args) < cmd.min || cmd.max >= 0 && len(inv.args) > cmd.max {
+		return nil, fmt.Errorf("%s: usage: %s %s", cmd.name, cmd.name, cmd.args)
+	}
+	return inv, nil
+}
+
+func (cmd *command) accepts(opt string, hasValue bool) bool {
+	for _, o := range cmd.options {
+		if o == opt && !hasValue || o == opt+"=" && hasValue {
+			return true
+		}
+	}
+	return false
+}
+
+var commandsByName = make(map[string]*command)
+
+func init() {
+	for _, cmd := range commands {
+		commandsByName[cmd.name] = cmd
+	}
+}
+
+var commands = []*command{
+	// Logical switches
+	{name: "ls-add", args: "SWITCH", db: goovn.DBNB, min: 1, max: 1, options: []string{"may-exist"},
+		run: func(c goovn.Client, inv *invocation) (*goovn.OvnCommand, error) {
+			return c.LSAdd(inv.arg(0))
+		}},
+	{name: "ls-del", args: "SWITCH", db: goovn.DBNB, min: 1, max: 1, options: []string{"if-exists"},
+		run: func(c goovn.Client, inv *invocation) (*goovn.OvnCommand, error) {
+			return c.LSDel(inv.arg(0))
+		}},
+	{name: "ls-list", db: goovn.DBNB,
+		run: func(c goovn.Client, inv *invocation) (*goovn.OvnCommand, error) {
+			lss, err := c.LSList()
+			if err = listErr(err); err != nil {
+				return nil, err
+			}
+			sort.Slice(lss, func(i, j int) bool { return lss[i].Name < lss[j].Name })
+			inv.output = newTable("uuid", "name")
+			for _, ls := range lss {
+				inv.output.add(ls.UUID, ls.Name)
+			}
+			return nil, nil
+		}},
+	{name: "ls-lb-add", args: "SWITCH LB", db: goovn.DBNB, min: 2, max: 2,
+		run: func(c goovn.Client, inv *invocation) (*goovn.OvnCommand, error) {
+			return c.LSLBAdd(inv.arg(0), inv.arg(1))
+		}},
+	{name: "ls-lb-del", args: "SWITCH LB", db: goovn.DBNB, min: 2, max: 2, options: []string{"if-exists"},
+		run: func(c goovn.Client, inv *invocation) (*goovn.OvnCommand, error) {
+			return c.LSLBDel(inv.arg(0), inv.arg(1))
+		}},
+	{name: "ls-lb-list", args: "SWITCH", db: goovn.DBNB, min: 1, max: 1,
+		run: func(c goovn.Client, inv *invocation) (*goovn.OvnCommand, error) {
+			lbs, err := c.LSLBList(inv.arg(0))
+			if err != nil {
+				return nil, err
+			}
+			inv.output = lbTable(lbs)
+			return nil, nil
+		}},
+
+	// Logical switch ports
+	{name: "lsp-add", args: "SWITCH PORT", db: goovn.DBNB, min: 2, max: 2, options: []string{"may-exist"},
+		run: func(c goovn.Client, inv *invocation) (*goovn.OvnCommand, error) {
+			return c.LSPAdd(inv.arg(0), inv.arg(1))
+		}},
+	{name: "lsp-del", args: "PORT", db: goovn.DBNB, min: 1, max: 1, options: []string{"if-exists"},
+		run: func(c goovn.Client, inv *invocation) (*goovn.OvnCommand, error) {
+			return c.LSPDel(inv.arg(0))
+		}},
+	{name: "lsp-list", args: "SWITCH", db: goovn.DBNB, min: 1, max: 1,
+		run: func(c goovn.Client, inv *invocation) (*goovn.OvnCommand, error) {
+			lsps, err := c.LSPList(inv.arg(0))
+			if err != nil {
+				return nil, err
+			}
+			sort.Slice(lsps, func(i, j int) bool { return lsps[i].Name < lsps[j].Name })
+			inv.output = newTable("uuid", "name", "type", "addresses")
+			for _, lsp := range lsps {
+				inv.output.add(lsp.UUID, lsp.Name, lsp.Type, lsp.Addresses)
+			}
+			return nil, nil
+		}},
+	{name: "lsp-set-addresses", args: "PORT [ADDRESS]...", db: goovn.DBNB, min: 1, max: -1,
+		run: func(c goovn.Client, inv *invocation) (*goovn.OvnCommand, error) {
+			return c.LSPSetAddress(inv.arg(0), inv.args[1:]...)
+		}},
+	{name: "lsp-get-addresses", args: "PORT", db: goovn.DBNB, min: 1, max: 1,
+		run: func(c goovn.Client, inv *invocation) (*goovn.OvnCommand, error) {
+			lsp, err := c.LSPGet(inv.arg(0))
+			if err != nil {
+				return nil, err
+			}
+			inv.output = listTable("address", lsp.Addresses)
+			return nil, nil
+		}},
+	{name: "lsp-set-port-security", args: "PORT [ADDRS]...", db: goovn.DBNB, min: 1, max: -1,
+		run: func(c goovn.Client, inv *invocation) (*goovn.OvnCommand, error) {
+			return c.LSPSetPortSecurity(inv.arg(0), inv.args[1:]...)
+		}},
+	{name: "lsp-get-port-security", args: "PORT", db: goovn.DBNB, min: 1, max: 1,
+		run: func(c goovn.Client, inv *invocation) (*goovn.OvnCommand, error) {
+			lsp, err := c.LSPGet(inv.arg(0))
+			if err != nil {
+				return nil, err
+			}
+			inv.output = listTable("port_security", lsp.PortSecurity)
+			return nil, nil
+		}},
+	{name: "lsp-set-type", args: "PORT TYPE", db: goovn.DBNB, min: 2, max: 2,
+		run: func(c goovn.Client, inv *invocation) (*goovn.OvnCommand, error) {
+			return c.LSPSetType(inv.arg(0), inv.arg(1))
+		}},
+	{name: "lsp-get-type", args: "PORT", db: goovn.DBNB, min: 1, max: 1,
+		run: func(c goovn.Client, inv *invocation) (*goovn.OvnCommand, error) {
+			lsp, err := c.LSPGet(inv.arg(0))
+			if err != nil {
+				return nil, err
+			}
+			inv.output = newTable("type")
+			inv.output.add(lsp.Type)
+			return nil, nil
+		}},
+	{name: "lsp-set-options", args: "PORT [KEY=VALUE]...", db: goovn.DBNB, min: 1, max: -1,
+		run: func(c goovn.Client, inv *invocation) (*goovn.OvnCommand, error) {
+			options, err := parseKeyValues(inv.args[1:])
+			if err != nil {
+				return nil, err
+			}
+			return c.LSPSetOptions(inv.arg(0), options)
+		}},
+	{name: "lsp-get-options", args: "PORT", db: goovn.DBNB, min: 1, max: 1,
+		run: func(c goovn.Client, inv *invocation) (*goovn.OvnCommand, error) {
+			lsp, err := c.LSPGet(inv.arg(0))
+			if err != nil {
+				return nil, err
+			}
+			inv.output = mapTable(lsp.Options)
+			return nil, nil
+		}},
+
+	// Logical routers
+	{name: "lr-add", args: "ROUTER", db: goovn.DBNB, min: 1, max: 1, options: []string{"may-exist"},
+		run: func(c goovn.Client, inv *invocation) (*goovn.OvnCommand, error) {
+			return c.LRAdd(inv.arg(0), nil)
+		}},
+	{name: "lr-del", args: "ROUTER", db: goovn.DBNB, min: 1, max: 1, options: []string{"if-exists"},
+		run: func(c goovn.Client, inv *invocation) (*goovn.OvnCommand, error) {
+			return c.LRDel(inv.arg(0))
+		}},
+	{name: "lr-list", db: goovn.DBNB,
+		run: func(c goovn.Client, inv *invocation) (*goovn.OvnCommand, error) {
+			lrs, err := c.LRList()
+			if err = listErr(err); err != nil {
+				return nil, err
+			}
+			sort.Slice(lrs, func(i, j int) bool { return lrs[i].Name < lrs[j].Name })
+			inv.output = newTable("uuid", "name")
+			for _, lr := range lrs {
+				inv.output.add(lr.UUID, lr.Name)
+			}
+			return nil, nil
+		}},
+	{name: "lr-lb-add", args: "ROUTER LB", db: goovn.DBNB, min: 2, max: 2,
+		run: func(c goovn.Client, inv *invocation) (*goovn.OvnCommand, error) {
+			return c.LRLBAdd(inv.arg(0), inv.arg(1))
+		}},
+	{name: "lr-lb-del", args: "ROUTER LB", db: goovn.DBNB, min: 2, max: 2, options: []string{"if-exists"},
+		run: func(c goovn.Client, inv *invocation) (*goovn.OvnCommand, error) {
+			return c.LRLBDel(inv.arg(0), inv.arg(1))
+		}},
+	{name: "lr-lb-list", args: "ROUTER", db: goovn.DBNB, min: 1, max: 1,
+		run: func(c goovn.Client, inv *invocation) (*goovn.OvnCommand, error) {
+			lbs, err := c.LRLBList(inv.arg(0))
+			if err != nil {
+				return nil, err
+			}
+			inv.output = lbTable(lbs)
+			return nil, nil
+		}},
+
+	// Logical router ports
+	{name: "lrp-add", args: "ROUTER PORT MAC NETWORK... [peer=PEER]", db: goovn.DBNB, min: 4, max: -1, options: []string{"may-exist"},
+		run: func(c goovn.Client, inv *invocation) (*goovn.OvnCommand, error) {
+			networks := inv.args[3:]
+			peer := ""
+			if last := networks[len(networks)-1]; strings.HasPrefix(last, "peer=") {
+				peer = strings.TrimPrefix(last, "peer=")
+				networks = networks[:len(networks)-1]
+			}
+			if len(networks) == 0 {
+				return nil, errors.New("missing network")
+			}
+			return c.LRPAdd(inv.arg(0), inv.arg(1), inv.arg(2), networks, peer, nil)
+		}},
+	{name: "lrp-del", args: "PORT", db: goovn.DBNB, min: 1, max: 1, options: []string{"if-exists"},
+		run: func(c goovn.Client, inv *invocation) (*goovn.OvnCommand, error) {
+			lr, err := routerOfPort(c, inv.arg(0))
+			if err != nil {
+				return nil, err
+			}
+			return c.LRPDel(lr, inv.arg(0))
+		}},
+	{name: "lrp-list", args: "ROUTER", db: goovn.DBNB, min: 1, max: 1,
+		run: func(c goovn.Client, inv *invocation) (*goovn.OvnCommand, error) {
+			lrps, err := c.LRPList(inv.arg(0))
+			if err != nil {
+				return nil, err
+			}
+			sort.Slice(lrps, func(i, j int) bool { return lrps[i].Name < lrps[j].Name })
+			inv.output = newTable("uuid", "name", "mac", "networks", "peer")
+			for _, lrp := range lrps {
+				inv.output.add(lrp.UUID, lrp.Name, lrp.MAC, lrp.Networks, lrp.Peer)
+			}
+			return nil, nil
+		}},
+
+	// Logical router static routes
+	{name: "lr-route-add", args: "ROUTER PREFIX NEXTHOP [PORT]", db: goovn.DBNB, min: 3, max: 4, options: []string{"may-exist", "policy="},
+		run: func(c goovn.Client, inv *invocation) (*goovn.OvnCommand, error) {
+			return c.LRSRAdd(inv.arg(0), inv.arg(1), inv.arg(2), optional(inv.arg(3)), optional(inv.opts["policy"]), nil)
+		}},
+	{name: "lr-route-del", args: "ROUTER [PREFIX [NEXTHOP [PORT]]]", db: goovn.DBNB, min: 1, max: 4, options: []string{"if-exists", "policy="},
+		run: func(c goovn.Client, inv *invocation) (*goovn.OvnCommand, error) {
+			if len(inv.args) > 1 {
+				return c.LRSRDel(inv.arg(0), inv.arg(1), optional(inv.arg(2)), optional(inv.arg(3)), optional(inv.opts["policy"]))
+			}
+			routes, err := c.LRSRList(inv.arg(0))
+			if err != nil {
+				return nil, err
+			}
+			var cmds []*goovn.OvnCommand
+			for _, route := range routes {
+				cmd, err := c.LRSRDelByUUID(inv.arg(0), route.UUID)
+				if err != nil {
+					return nil, err
+				}
+				cmds = append(cmds, cmd)
+			}
+			return merge(cmds), nil
+		}},
+	{name: "lr-route-list", args: "ROUTER", db: goovn.DBNB, min: 1, max: 1,
+		run: func(c goovn.Client, inv *invocation) (*goovn.OvnCommand, error) {
+			routes, err := c.LRSRList(inv.arg(0))
+			if err != nil {
+				return nil, err
+			}
+			sort.Slice(routes, func(i, j int) bool { return routes[i].IPPrefix < routes[j].IPPrefix })
+			inv.output = newTable("uuid", "ip_prefix", "nexthop", "output_port", "policy")
+			for _, route := range routes {
+				inv.output.add(route.UUID, route.IPPrefix, route.Nexthop, deref(route.OutputPort), deref(route.Policy))
+			}
+			return nil, nil
+		}},
+
+	// Logical router policies
+	{name: "lr-policy-add", args: "ROUTER PRIORITY MATCH ACTION [NEXTHOP]", db: goovn.DBNB, min: 4, max: 5, options: []string{"may-exist"},
+		run: func(c goovn.Client, inv *invocation) (*goovn.OvnCommand, error) {
+			priority, err := parsePriority(inv.arg(1))
+			if err != nil {
+				return nil, err
+			}
+			return c.LRPolicyAdd(inv.arg(0), priority, inv.arg(2), inv.arg(3), optional(inv.arg(4)), nil, nil, nil)
+		}},
+	{name: "lr-policy-del", args: "ROUTER [PRIORITY [MATCH]]", db: goovn.DBNB, min: 1, max: 3, options: []string{"if-exists"},
+		run: func(c goovn.Client, inv *invocation) (*goovn.OvnCommand, error) {
+			if len(inv.args) == 1 {
+				return c.LRPolicyDelAll(inv.arg(0))
+			}
+			priority, err := parsePriority(inv.arg(1))
+			if err != nil {
+				return nil, err
+			}
+			return c.LRPolicyDel(inv.arg(0), priority, optional(inv.arg(2)))
+		}},
+	{name: "lr-policy-list", args: "ROUTER", db: goovn.DBNB, min: 1, max: 1,
+		run: func(c goovn.Client, inv *invocation) (*goovn.OvnCommand, error) {
+			policies, err := c.LRPolicyList(inv.arg(0))
+			if err != nil {
+				return nil, err
+			}
+			sort.Slice(policies, func(i, j int) bool {
+				if policies[i].Priority != policies[j].Priority {
+					return policies[i].Priority > policies[j].Priority
+				}
+				return policies[i].Match < policies[j].Match
+			})
+			inv.output = newTable("uuid", "priority", "match", "action", "nexthop")
+			for _, p := range policies {
+				inv.output.add(p.UUID, p.Priority, p.Match, p.Action, deref(p.Nexthop))
+			}
+			return nil, nil
+		}},
+
+	// NAT
+	{name: "lr-nat-add", args: "ROUTER TYPE EXTERNAL_IP LOGICAL_IP [LOGICAL_PORT EXTERNAL_MAC]", db: goovn.DBNB, min: 4, max: 6, options: []string{"may-exist"},
+		run: func(c goovn.Client, inv *invocation) (*goovn.OvnCommand, error) {
+			if len(inv.args) == 5 {
+				return nil, errors.New("both LOGICAL_PORT and EXTERNAL_MAC must be given")
+			}
+			return c.LRNATAdd(inv.arg(0), inv.arg(1), inv.arg(2), inv.arg(3), nil, inv.args[4:]...)
+		}},
+	{name: "lr-nat-del", args: "ROUTER [TYPE [IP]]", db: goovn.DBNB, min: 1, max: 3, options: []string{"if-exists"},
+		run: func(c goovn.Client, inv *invocation) (*goovn.OvnCommand, error) {
+			return c.LRNATDel(inv.arg(0), inv.arg(1), inv.args[min(2, len(inv.args)):]...)
+		}},
+	{name: "lr-nat-list", args: "ROUTER", db: goovn.DBNB, min: 1, max: 1,
+		run: func(c goovn.Client, inv *invocation) (*goovn.OvnCommand, error) {
+			nats, err := c.LRNATList(inv.arg(0))
+			if err != nil {
+				return nil, err
+			}
+			sort.Slice(nats, func(i, j int) bool {
+				if nats[i].Type != nats[j].Type {
+					return nats[i].Type < nats[j].Type
+				}
+				return nats[i].ExternalIP < nats[j].ExternalIP
+			})
+			inv.output = newTable("uuid", "type", "external_ip", "logical_ip", "external_mac", "logical_port")
+			for _, nat := range nats {
+				inv.output.add(nat.UUID, nat.Type, nat.ExternalIP, nat.LogicalIP, nat.ExternalMAC, nat.LogicalPort)
+			}
+			return nil, nil
+		}},
+
+	// Load balancers
+	{name: "lb-add", args: "LB VIP IP[,IP]... [PROTOCOL]", db: goovn.DBNB, min: 3, max: 4, options: []string{"may-exist"},
+		run: func(c goovn.Client, inv *invocation) (*goovn.OvnCommand, error) {
+			protocol := inv.arg(3)
+			if protocol == "" {
+				protocol = "tcp"
+			}
+			return c.LBAdd(inv.arg(0), inv.arg(1), protocol, strings.Split(inv.arg(2), ","))
+		}},
+	{name: "lb-del", args: "LB", db: goovn.DBNB, min: 1, max: 1, options: []string{"if-exists"},
+		run: func(c goovn.Client, inv *invocation) (*goovn.OvnCommand, error) {
+			return c.LBDel(inv.arg(0))
+		}},
+	{name: "lb-list", db: goovn.DBNB,
+		run: func(c goovn.Client, inv *invocation) (*goovn.OvnCommand, error) {
+			lbs, err := c.LBList()
+			if err = listErr(err); err != nil {
+				return nil, err
+			}
+			inv.output = lbTable(lbs)
+			return nil, nil
+		}},
+
+	// ACLs
+	{name: "acl-add", args: "ENTITY DIRECTION PRIORITY MATCH VERDICT", db: goovn.DBNB, min: 5, max: 5,
+		options: []string{"may-exist", "type=", "log", "name=", "severity=", "meter="},
+		run: func(c goovn.Client, inv *invocation) (*goovn.OvnCommand, error) {
+			entityType, err := aclEntityType(inv)
+			if err != nil {
+				return nil, err
+			}
+			priority, err := parsePriority(inv.arg(2))
+			if err != nil {
+				return nil, err
+			}
+			return c.ACLAddEntity(entityType, inv.arg(0), inv.opts["name"], inv.arg(1), inv.arg(3), inv.arg(4), priority,
+				nil, inv.has("log"), inv.opts["meter"], inv.opts["severity"])
+		}},
+	{name: "acl-del", args: "ENTITY [DIRECTION [PRIORITY MATCH]]", db: goovn.DBNB, min: 1, max: 4, options: []string{"type="},
+		run: func(c goovn.Client, inv *invocation) (*goovn.OvnCommand, error) {
+			if len(inv.args) == 3 {
+				return nil, errors.New("both PRIORITY and MATCH must be given")
+			}
+			entityType, err := aclEntityType(inv)
+			if err != nil {
+				return nil, err
+			}
+			acls, err := c.ACLListEntity(entityType, inv.arg(0))
+			if err != nil {
+				return nil, err
+			}
+			var cmds []*goovn.OvnCommand
+			for _, acl := range acls {
+				if inv.arg(1) != "" && acl.Direction != inv.arg(1) ||
+					inv.arg(2) != "" && (strconv.Itoa(acl.Priority) != inv.arg(2) || acl.Match != inv.arg(3)) {
+					continue
+				}
+				cmd, err := c.ACLDelEntity(entityType, inv.arg(0), acl.UUID)
+				if err != nil {
+					return nil, err
+				}
+				cmds = append(cmds, cmd)
+			}
+			return merge(cmds), nil
+		}},
+	{name: "acl-list", args: "ENTITY", db: goovn.DBNB, min: 1, max: 1, options: []string{"type="},
+		run: func(c goovn.Client, inv *invocation) (*goovn.OvnCommand, error) {
+			entityType, err := aclEntityType(inv)
+			if err != nil {
+				return nil, err
+			}
+			acls, err := c.ACLListEntity(entityType, inv.arg(0))
+			if err != nil {
+				return nil, err
+			}
+			// like ovn-nbctl, by direction and descending priority
+			sort.Slice(acls, func(i, j int) bool {
+				if acls[i].Direction != acls[j].Direction {
+					return acls[i].Direction < acls[j].Direction
+				}
+				if acls[i].Priority != acls[j].Priority {
+					return acls[i].Priority > acls[j].Priority
+				}
+				return acls[i].Match < acls[j].Match
+			})
+			inv.output = newTable("uuid", "direction", "priority", "match", "action", "log")
+			for _, acl := range acls {
+				inv.output.add(acl.UUID, acl.Direction, acl.Priority, acl.Match, acl.Action, acl.Log)
+			}
+			return nil, nil
+		}},
+
+	// Port groups
+	{name: "pg-add", args: "GROUP [PORT]...", db: goovn.DBNB, min: 1, max: -1, options: []string{"may-exist"},
+		run: func(c goovn.Client, inv *invocation) (*goovn.OvnCommand, error) {
+			ports, err := portUUIDs(c, inv.args[1:])
+			if err != nil {
+				return nil, err
+			}
+			return c.PortGroupAdd(inv.arg(0), ports, nil)
+		}},
+	{name: "pg-set-ports", args: "GROUP PORT...", db: goovn.DBNB, min: 2, max: -1,
+		run: func(c goovn.Client, inv *invocation) (*goovn.OvnCommand, error) {
+			ports, err := portUUIDs(c, inv.args[1:])
+			if err != nil {
+				return nil, err
+			}
+			return c.PortGroupUpdate(inv.arg(0), ports, nil)
+		}},
+	{name: "pg-del", args: "GROUP", db: goovn.DBNB, min: 1, max: 1, options: []string{"if-exists"},
+		run: func(c goovn.Client, inv *invocation) (*goovn.OvnCommand, error) {
+			return c.PortGroupDel(inv.arg(0))
+		}},
+	{name: "pg-get", args: "GROUP", db: goovn.DBNB, min: 1, max: 1,
+		run: func(c goovn.Client, inv *invocation) (*goovn.OvnCommand, error) {
+			pg, err := c.PortGroupGet(inv.arg(0))
+			if err != nil {
+				return nil, err
+			}
+			ports, err := portNames(c, pg.Ports)
+			if err != nil {
+				return nil, err
+			}
+			inv.output = newTable("uuid", "name", "ports")
+			inv.output.add(pg.UUID, pg.Name, ports)
+			return nil, nil
+		}},
+
+	// Meters
+	{name: "meter-add", args: "NAME ACTION RATE UNIT [BURST]", db: goovn.DBNB, min: 4, max: 5, options: []string{"may-exist"},
+		run: func(c goovn.Client, inv *invocation) (*goovn.OvnCommand, error) {
+			rate, err := strconv.Atoi(inv.arg(2))
+			if err != nil {
+				return nil, fmt.Errorf("invalid rate %q", inv.arg(2))
+			}
+			burst := 0
+			if len(inv.args) > 4 {
+				if burst, err = strconv.Atoi(inv.arg(4)); err != nil {
+					return nil, fmt.Errorf("invalid burst %q", inv.arg(4))
+				}
+			}
+			return c.MeterAdd(inv.arg(0), inv.arg(1), rate, inv.arg(3), nil, burst)
+		}},
+	{name: "meter-del", args: "[NAME]", db: goovn.DBNB, min: 0, max: 1, options: []string{"if-exists"},
+		run: func(c goovn.Client, inv *invocation) (*goovn.OvnCommand, error) {
+			return c.MeterDel(inv.args...)
+		}},
+	{name: "meter-list", db: goovn.DBNB,
+		run: func(c goovn.Client, inv *invocation) (*goovn.OvnCommand, error) {
+			meters, err := c.MeterList()
+			if err = listErr(err); err != nil {
+				return nil, err
+			}
+			bands, err := c.MeterBandsList()
+			if err = listErr(err); err != nil {
+				return nil, err
+			}
+			bandsByUUID := make(map[string]*goovn.MeterBand, len(bands))
+			for _, band := range bands {
+				bandsByUUID[band.UUID] = band
+			}
+			sort.Slice(meters, func(i, j int) bool { return meters[i].Name < meters[j].Name })
+			inv.output = newTable("uuid", "name", "unit", "action", "rate", "burst_size")
+			for _, meter := range meters {
+				for _, uuid := range meter.Bands {
+					if band, ok := bandsByUUID[uuid]; ok {
+						inv.output.add(meter.UUID, meter.Name, meter.Unit, band.Action, band.Rate, band.BurstSize)
+					}
+				}
+			}
+			return nil, nil
+		}},
+
+	// Chassis
+	{name: "chassis-add", args: "CHASSIS ENCAP-TYPE[,ENCAP-TYPE]... ENCAP-IP", db: goovn.DBSB, min: 3, max: 3, options: []string{"may-exist"},
+		run: func(c goovn.Client, inv *invocation) (*goovn.OvnCommand, error) {
+			return c.ChassisAdd(inv.arg(0), "", strings.Split(inv.arg(1), ","), inv.arg(2), nil, nil, nil)
+		}},
+	{name: "chassis-del", args: "CHASSIS", db: goovn.DBSB, min: 1, max: 1, options: []string{"if-exists"},
+		run: func(c goovn.Client, inv *invocation) (*goovn.OvnCommand, error) {
+			if _, err := c.ChassisGet(inv.arg(0)); err != nil {
+				return nil, err
+			}
+			return c.ChassisDel(inv.arg(0))
+		}},
+	{name: "chassis-list", db: goovn.DBSB,
+		run: func(c goovn.Client, inv *invocation) (*goovn.OvnCommand, error) {
+			chassis, err := c.ChassisList()
+			if err = listErr(err); err != nil {
+				return nil, err
+			}
+			sort.Slice(chassis, func(i, j int) bool { return chassis[i].Name < chassis[j].Name })
+			inv.output = newTable("uuid", "name", "hostname", "encaps")
+			for _, ch := range chassis {
+				encaps, err := c.EncapList(ch.Name)
+				if err = listErr(err); err != nil {
+					return nil, err
+				}
+				var list []string
+				for _, encap := range encaps {
+					list = append(list, encap.Encaptype+":"+encap.Ip)
+				}
+				sort.Strings(list)
+				inv.output.add(ch.UUID, ch.Name, ch.Hostname, list)
+			}
+			return nil, nil
+		}},
+}
+
+// listErr returns nil for the error of listing a table that has no rows yet
+func listErr(err error) error {
+	if err == goovn.ErrorSchema || err == goovn.ErrorNotFound {
+		return nil
+	}
+	return err
+}
+
+// merge combines the operations of cmds into one command
+func merge(cmds []*goovn.OvnCommand) *goovn.OvnCommand {
+	if len(cmds) == 0 {
+		return nil
+	}
+	merged := &goovn.OvnCommand{}
+	for _, cmd := range cmds {
+		merged.Operations = append(merged.Operations, cmd.Operations...)
+	}
+	return merged
+}
+
+func optional(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func parsePriority(s string) (int, error) {
+	priority, err := strconv.Atoi(s)
+	if err != nil || priority < 0 || priority > 32767 {
+		return 0, fmt.Errorf("invalid priority %q", s)
+	}
+	return priority, nil
+}
+
+func parseKeyValues(args []string) (map[string]string, error) {
+	kv := make(map[string]string, len(args))
+	for _, arg := range args {
+		i := strings.Index(arg, "=")
+		if i <= 0 {
+			return nil, fmt.Errorf("%q is not KEY=VALUE", arg)
+		}
+		kv[arg[:i]] = arg[i+1:]
+	}
+	return kv, nil
+}
+
+func aclEntityType(inv *invocation) (goovn.EntityType, error) {
+	switch inv.opts["type"] {
+	case "", "switch":
+		return goovn.LOGICAL_SWITCH, nil
+	case "port-group":
+		return goovn.PORT_GROUP, nil
+	}
+	return "", fmt.Errorf("invalid --type %q, switch or port-group", inv.opts["type"])
+}
+
+// routerOfPort returns the name of the logical router with port lrp
+func routerOfPort(c goovn.Client, lrp string) (string, error) {
+	lrs, err := c.LRList()
+	if err = listErr(err); err != nil {
+		return "", err
+	}
+	for _, lr := range lrs {
+		lrps, err := c.LRPList(lr.Name)
+		if err = listErr(err); err != nil {
+			return "", err
+		}
+		for _, p := range lrps {
+			if p.Name == lrp {
+				return lr.Name, nil
+			}
+		}
+	}
+	return "", goovn.ErrorNotFound
+}
+
+// portUUIDs returns the UUIDs of the logical switch ports with names
+func portUUIDs(c goovn.Client, names []string) ([]string, error) {
+	uuids := make([]string, 0, len(names))
+	for _, name := range names {
+		lsp, err := c.LSPGet(name)
+		if err != nil {
+			return nil, fmt.Errorf("port %s: %v", name, err)
+		}
+		uuids = append(uuids, lsp.UUID)
+	}
+	return uuids, nil
+}
+
+// portNames returns the sorted names of the logical switch ports with uuids
+func portNames(c goovn.Client, uuids []string) ([]string, error) {
+	lss, err := c.LSList()
+	if err = listErr(err); err != nil {
+		return nil, err
+	}
+	wanted := make(map[string]bool, len(uuids))
+	for _, uuid := range uuids {
+		wanted[uuid] = true
+	}
+	var names []string
+	for _, ls := range lss {
+		lsps, err := c.LSPList(ls.Name)
+		if err = listErr(err); err != nil {
+			return nil, err
+		}
+		for _, lsp := range lsps {
+			if wanted[lsp.UUID] {
+				names = append(names, lsp.Name)
+			}
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+func lbTable(lbs []*goovn.LoadBalancer) *table {
+	sort.Slice(lbs, func(i, j int) bool { return lbs[i].Name < lbs[j].Name })
+	t := newTable("uuid", "name", "protocol", "vips")
+	for _, lb := range lbs {
+		t.add(lb.UUID, lb.Name, lb.Protocol, lb.VIPs)
+	}
+	return t
+}
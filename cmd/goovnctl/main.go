@@ -0,0 +1,242 @@
+/**
+ * Copyright (c) 2020 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+// goovnctl manages the OVN northbound and southbound databases with the
+// commands of goovn.Client, mirroring the most common commands of
+// ovn-nbctl and ovn-sbctl.
+//
+//	goovnctl [OPTIONS] [COMMAND-OPTIONS] COMMAND [ARG...] [-- [COMMAND-OPTIONS] COMMAND [ARG...]]...
+//
+// Commands separated by "--" are executed in a single transaction, later
+// commands see the changes of earlier ones:
+//
+//	goovnctl ls-add sw0 -- lsp-add sw0 p1 -- lsp-set-addresses p1 "00:00:00:00:00:01 10.0.0.1"
+//
+// The chassis-* commands use the southbound database, all other commands
+// the northbound database. The database is OVN_NB_DB or OVN_SB_DB from the
+// environment unless --db is given. Output of list commands is a table, or
+// JSON with --format=json. Run goovnctl --help for the list of commands.
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+	"time"
+
+	goovn "github.com/ebay/go-ovn"
+)
+
+const (
+	defaultNBDB = "unix:/var/run/ovn/ovnnb_db.sock"
+	defaultSBDB = "unix:/var/run/ovn/ovnsb_db.sock"
+)
+
+type options struct {
+	db         string
+	format     string
+	timeout    time.Duration
+	privateKey string
+	cert       string
+	caCert     string
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// run executes the command line args and returns the exit status
+func run(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("goovnctl", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	var opts options
+	fs.StringVar(&opts.db, "db", "", "database address, e.g. tcp:127.0.0.1:6641, OVN_NB_DB or OVN_SB_DB if empty")
+	fs.StringVar(&opts.format, "format", formatTable, "output format of list commands, table or json")
+	fs.StringVar(&opts.format, "f", formatTable, "shorthand for --format")
+	fs.DurationVar(&opts.timeout, "timeout", 0, "give up after this long, e.g. 10s, never if 0")
+	fs.StringVar(&opts.privateKey, "private-key", "", "private key file for ssl: addresses")
+	fs.StringVar(&opts.cert, "certificate", "", "certificate file for ssl: addresses")
+	fs.StringVar(&opts.caCert, "ca-cert", "", "CA certificate file for ssl: addresses")
+	fs.Usage = func() { usage(fs) }
+
+	n := globalOptions(fs, args)
+	if err := fs.Parse(args[:n]); err != nil {
+		if err == flag.ErrHelp {
+			return 0
+		}
+		return 2
+	}
+	if opts.format != formatTable && opts.format != formatJSON {
+		fmt.Fprintf(stderr, "goovnctl: unknown format %q\n", opts.format)
+		return 2
+	}
+	invs, err := parseCommands(args[n:])
+	if err != nil {
+		fmt.Fprintf(stderr, "goovnctl: %v\n", err)
+		return 2
+	}
+	if err := execute(invs, &opts); err != nil {
+		fmt.Fprintf(stderr, "goovnctl: %v\n", err)
+		return 1
+	}
+	for _, inv := range invs {
+		if inv.output == nil {
+			continue
+		}
+		if err := inv.output.write(stdout, opts.format); err != nil {
+			fmt.Fprintf(stderr, "goovnctl: %v\n", err)
+			return 1
+		}
+	}
+	return 0
+}
+
+// globalOptions returns the number of leading args that are options of fs,
+// the command options and commands follow them
+func globalOptions(fs *flag.FlagSet, args []string) int {
+	i := 0
+	for i < len(args) {
+		arg := args[i]
+		if arg == "--" || !strings.HasPrefix(arg, "-") {
+			break
+		}
+		name := strings.TrimLeft(arg, "-")
+		if name == "h" || name == "help" {
+			i++
+			continue
+		}
+		hasValue := strings.Contains(name, "=")
+		if hasValue {
+			name = name[:strings.Index(name, "=")]
+		}
+		f := fs.Lookup(name)
+		if f == nil {
+			break
+		}
+		i++
+		if !hasValue && i < len(args) {
+			i++
+		}
+	}
+	return i
+}
+
+func usage(fs *flag.FlagSet) {
+	w := fs.Output()
+	fmt.Fprintf(w, "usage: goovnctl [OPTIONS] [COMMAND-OPTIONS] COMMAND [ARG...] [-- [COMMAND-OPTIONS] COMMAND [ARG...]]...\n\nOptions:\n")
+	fs.PrintDefaults()
+	fmt.Fprintf(w, "\nCommands:\n")
+	for _, cmd := range commands {
+		line := cmd.name
+		if cmd.args != "" {
+			line += " " + cmd.args
+		}
+		for _, opt := range cmd.options {
+			if strings.HasSuffix(opt, "=") {
+				opt += strings.ToUpper(strings.TrimSuffix(opt, "="))
+			}
+			line = "[--" + opt + "] " + line
+		}
+		fmt.Fprintf(w, "  %s\n", line)
+	}
+}
+
+// execute runs the commands in one transaction against the database they use
+func execute(invs []*invocation, opts *options) error {
+	db := invs[0].cmd.db
+	addr := opts.db
+	if addr == "" {
+		addr = defaultAddr(db)
+	}
+	cfg := &goovn.Config{Db: db, Addr: addr, Logger: errorLogger{}}
+	if opts.privateKey != "" || opts.cert != "" || opts.caCert != "" {
+		tlsConfig, err := loadTLSConfig(opts)
+		if err != nil {
+			return err
+		}
+		cfg.TLSConfig = tlsConfig
+	}
+	ctx := context.Background()
+	if opts.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.timeout)
+		defer cancel()
+		cfg.Timeout = opts.timeout
+	}
+	c, err := goovn.NewClientContext(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	txn := c.NewTransaction()
+	for _, inv := range invs {
+		if err := txn.Add(inv.build); err != nil {
+			return fmt.Errorf("%s: %v", inv, err)
+		}
+	}
+	_, err = txn.CommitContext(ctx)
+	return err
+}
+
+// errorLogger logs only the errors of the client, goovnctl is silent on
+// success
+type errorLogger struct{}
+
+func (errorLogger) Info(msg string, keysAndValues ...interface{}) {}
+
+func (errorLogger) Error(err error, msg string, keysAndValues ...interface{}) {
+	fmt.Fprintln(os.Stderr, append([]interface{}{"goovnctl:", msg, "error=" + err.Error()}, keysAndValues...)...)
+}
+
+func defaultAddr(db string) string {
+	if db == goovn.DBSB {
+		if addr := os.Getenv("OVN_SB_DB"); addr != "" {
+			return addr
+		}
+		return defaultSBDB
+	}
+	if addr := os.Getenv("OVN_NB_DB"); addr != "" {
+		return addr
+	}
+	return defaultNBDB
+}
+
+func loadTLSConfig(opts *options) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(opts.cert, opts.privateKey)
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig := &tls.Config{Certificates: []tls.Certificate{cert}}
+	if opts.caCert != "" {
+		pem, err := ioutil.ReadFile(opts.caCert)
+		if err != nil {
+			return nil, err
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("%s: no certificates found", opts.caCert)
+		}
+		tlsConfig.RootCAs = pool
+	}
+	return tlsConfig, nil
+}
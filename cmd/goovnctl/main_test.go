@@ -0,0 +1,139 @@
+/**
+ * Copyright (c) 2020 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/ebay/go-ovn/goovntest"
+	"github.com/stretchr/testify/assert"
+)
+
+type ctl struct {
+	t    *testing.T
+	addr string
+}
+
+// run runs goovnctl with args and returns its output, failing the test if
+// it does not exit with code
+func (c *ctl) run(code int, args ...string) string {
+	var stdout, stderr bytes.Buffer
+	if got := run(append([]string{"--db", c.addr}, args...), &stdout, &stderr); got != code {
+		c.t.Fatalf("goovnctl %s: exit code %d, want %d: %s", strings.Join(args, " "), got, code, stderr.String())
+	}
+	return stdout.String()
+}
+
+// lines returns the rows of the table output without the header
+func lines(out string) []string {
+	rows := strings.Split(strings.TrimSpace(out), "\n")[1:]
+	for i, row := range rows {
+		rows[i] = strings.Join(strings.Fields(row), " ")
+	}
+	return rows
+}
+
+func TestGoovnctl(t *testing.T) {
+	c := &ctl{t: t, addr: goovntest.StartServer(t)}
+
+	assert.Empty(t, lines(c.run(0, "ls-list")))
+	c.run(0, "ls-add", "sw0", "--", "lsp-add", "sw0", "p1", "--", "lsp-add", "sw0", "p2",
+		"--", "lsp-set-addresses", "p1", "00:00:00:00:00:01 10.0.0.1",
+		"--", "lsp-set-options", "p2", "a=1", "b=2")
+	c.run(1, "ls-add", "sw0")
+	c.run(0, "--may-exist", "ls-add", "sw0")
+	assert.Equal(t, []string{"00:00:00:00:00:01 10.0.0.1"}, lines(c.run(0, "lsp-get-addresses", "p1")))
+	assert.Equal(t, []string{"a 1", "b 2"}, lines(c.run(0, "lsp-get-options", "p2")))
+
+	var out struct {
+		Headings []string
+		Data     [][]interface{}
+	}
+	if err := json.Unmarshal([]byte(c.run(0, "--format=json", "lsp-list", "sw0")), &out); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []string{"uuid", "name", "type", "addresses"}, out.Headings)
+	if assert.Len(t, out.Data, 2) {
+		assert.Equal(t, "p1", out.Data[0][1])
+		assert.Equal(t, []interface{}{"00:00:00:00:00:01 10.0.0.1"}, out.Data[0][3])
+	}
+
+	c.run(0, "lr-add", "lr0", "--", "lrp-add", "lr0", "lrp0", "00:00:00:00:01:01", "10.0.0.254/24",
+		"--", "lr-route-add", "lr0", "0.0.0.0/0", "192.168.0.1",
+		"--", "lr-policy-add", "lr0", "100", "ip4.src == 10.0.0.0/24", "allow",
+		"--", "lr-nat-add", "lr0", "snat", "192.168.0.10", "10.0.0.0/24",
+		"--", "lb-add", "lb0", "192.168.0.100:80", "10.0.0.1:80,10.0.0.2:80",
+		"--", "lr-lb-add", "lr0", "lb0")
+	assert.Len(t, lines(c.run(0, "lrp-list", "lr0")), 1)
+	assert.Len(t, lines(c.run(0, "lr-route-list", "lr0")), 1)
+	assert.Len(t, lines(c.run(0, "lr-policy-list", "lr0")), 1)
+	assert.Len(t, lines(c.run(0, "lr-nat-list", "lr0")), 1)
+	assert.Len(t, lines(c.run(0, "lr-lb-list", "lr0")), 1)
+	c.run(0, "lr-route-del", "lr0", "--", "lr-policy-del", "lr0", "--", "lr-nat-del", "lr0", "--", "lrp-del", "lrp0")
+	assert.Empty(t, lines(c.run(0, "lr-route-list", "lr0")))
+	assert.Empty(t, lines(c.run(0, "lr-policy-list", "lr0")))
+	assert.Empty(t, lines(c.run(0, "lr-nat-list", "lr0")))
+	assert.Empty(t, lines(c.run(0, "lrp-list", "lr0")))
+	c.run(1, "lrp-del", "lrp0")
+	c.run(0, "--if-exists", "lrp-del", "lrp0")
+
+	c.run(0, "acl-add", "sw0", "to-lport", "1000", "outport == \"p1\"", "allow",
+		"--", "--log", "--severity=info", "acl-add", "sw0", "from-lport", "900", "ip", "drop")
+	assert.Equal(t, []string{
+		"from-lport 900 ip drop true",
+		"to-lport 1000 outport == \"p1\" allow false",
+	}, withoutUUIDs(lines(c.run(0, "acl-list", "sw0"))))
+	c.run(0, "acl-del", "sw0", "from-lport")
+	assert.Len(t, lines(c.run(0, "acl-list", "sw0")), 1)
+
+	c.run(0, "pg-add", "pg0", "p1", "--", "pg-set-ports", "pg0", "p1", "p2",
+		"--", "--type=port-group", "acl-add", "pg0", "to-lport", "100", "ip", "allow")
+	assert.Equal(t, []string{"pg0 p1, p2"}, withoutUUIDs(lines(c.run(0, "pg-get", "pg0"))))
+	assert.Len(t, lines(c.run(0, "--type=port-group", "acl-list", "pg0")), 1)
+	c.run(0, "pg-del", "pg0")
+
+	c.run(0, "meter-add", "m0", "drop", "100", "pktps", "10")
+	assert.Equal(t, []string{"m0 pktps drop 100 10"}, withoutUUIDs(lines(c.run(0, "meter-list"))))
+	c.run(0, "meter-del", "m0")
+	assert.Empty(t, lines(c.run(0, "meter-list")))
+
+	c.run(0, "chassis-add", "ch0", "geneve,vxlan", "192.168.0.2")
+	assert.Equal(t, []string{"ch0 geneve:192.168.0.2, vxlan:192.168.0.2"}, withoutUUIDs(lines(c.run(0, "chassis-list"))))
+	c.run(0, "chassis-del", "ch0")
+	c.run(1, "chassis-del", "ch0")
+
+	// usage errors
+	c.run(2, "ls-add", "sw1", "--", "chassis-list")
+	c.run(2, "ls-add")
+	c.run(2, "--if-exists", "ls-add", "sw1")
+	c.run(2, "no-such-command")
+	c.run(2, "ls-add", "sw1", "--")
+	c.run(2, "--format=xml", "ls-list")
+	// a failed command aborts the whole transaction
+	c.run(1, "ls-add", "sw1", "--", "ls-add", "sw0")
+	assert.Equal(t, []string{"sw0"}, withoutUUIDs(lines(c.run(0, "ls-list"))))
+}
+
+func withoutUUIDs(rows []string) []string {
+	for i, row := range rows {
+		rows[i] = strings.SplitN(row, " ", 2)[1]
+	}
+	return rows
+}
@@ -0,0 +1,128 @@
+/**
+ * Copyright (c) 2020 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+)
+
+const (
+	formatTable = "table"
+	formatJSON  = "json"
+)
+
+// table is the output of a command
+type table struct {
+	columns []string
+	rows    [][]interface{}
+}
+
+func newTable(columns ...string) *table {
+	return &table{columns: columns}
+}
+
+func (t *table) add(values ...interface{}) {
+	t.rows = append(t.rows, values)
+}
+
+// listTable returns a table of one column with a row per value
+func listTable(column string, values []string) *table {
+	t := newTable(column)
+	for _, v := range values {
+		t.add(v)
+	}
+	return t
+}
+
+// mapTable returns a table of the keys and values of m sorted by key
+func mapTable(m map[interface{}]interface{}) *table {
+	t := newTable("key", "value")
+	s := stringMap(m)
+	for _, k := range sortedKeys(s) {
+		t.add(k, s[k])
+	}
+	return t
+}
+
+// write writes the table in format, as ovn-nbctl does for --format=json
+func (t *table) write(w io.Writer, format string) error {
+	if format == formatJSON {
+		// encoding/json cannot marshal the map[interface{}]interface{}
+		// columns
+		data := make([][]interface{}, len(t.rows))
+		for i, row := range t.rows {
+			data[i] = make([]interface{}, len(row))
+			for j, v := range row {
+				if m, ok := v.(map[interface{}]interface{}); ok {
+					v = stringMap(m)
+				}
+				data[i][j] = v
+			}
+		}
+		return json.NewEncoder(w).Encode(struct {
+			Headings []string        `json:"headings"`
+			Data     [][]interface{} `json:"data"`
+		}{t.columns, data})
+	}
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, strings.Join(t.columns, "\t"))
+	for _, row := range t.rows {
+		cells := make([]string, len(row))
+		for i, v := range row {
+			cells[i] = cell(v)
+		}
+		fmt.Fprintln(tw, strings.Join(cells, "\t"))
+	}
+	return tw.Flush()
+}
+
+func cell(v interface{}) string {
+	switch v := v.(type) {
+	case []string:
+		return strings.Join(v, ", ")
+	case map[interface{}]interface{}:
+		m := stringMap(v)
+		kvs := make([]string, 0, len(m))
+		for _, k := range sortedKeys(m) {
+			kvs = append(kvs, k+"="+m[k])
+		}
+		return strings.Join(kvs, ", ")
+	}
+	return fmt.Sprint(v)
+}
+
+func stringMap(m map[interface{}]interface{}) map[string]string {
+	s := make(map[string]string, len(m))
+	for k, v := range m {
+		s[fmt.Sprint(k)] = fmt.Sprint(v)
+	}
+	return s
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,326 @@
+/**
+ * Copyright (c) 2020 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+// goovnPath is the import path of the package of the Client interface
+const goovnPath = "github.com/ebay/go-ovn"
+
+// param is a parameter or result of a method
+type param struct {
+	Name     string
+	Type     string
+	Variadic bool
+}
+
+type method struct {
+	Name    string
+	Params  []param
+	Results []param
+	// Commands is the param of the commands written by the method, if any
+	Commands string
+}
+
+// Executes reports whether the method writes the commands it is given, the
+// mock records them. The other writes of a recorder, e.g. the commit of a
+// Transaction, are recorded from the transact requests of its Fallback.
+func (m method) Executes() bool {
+	return m.Commands != ""
+}
+
+// ReturnsTransaction reports whether the method returns a transaction, which
+// only a client creates, so the mock fails the test instead of returning nil
+func (m method) ReturnsTransaction() bool {
+	return len(m.Results) > 0 && m.Results[0].Type == "*goovn.Transaction"
+}
+
+// ArgNames returns the names of the params separated by commas
+func (m method) ArgNames() string {
+	names := make([]string, len(m.Params))
+	for i, p := range m.Params {
+		names[i] = p.Name
+	}
+	return strings.Join(names, ", ")
+}
+
+// CallArgs returns the arguments passing the params to another call
+func (m method) CallArgs() string {
+	args := m.ArgNames()
+	if len(m.Params) > 0 && m.Params[len(m.Params)-1].Variadic {
+		args += "..."
+	}
+	return args
+}
+
+// Signature returns the params and results of the method
+func (m method) Signature() string {
+	params := make([]string, len(m.Params))
+	for i, p := range m.Params {
+		typ := p.Type
+		if p.Variadic {
+			typ = "..." + strings.TrimPrefix(typ, "[]")
+		}
+		params[i] = p.Name + " " + typ
+	}
+	results := make([]string, len(m.Results))
+	for i, r := range m.Results {
+		results[i] = r.Type
+	}
+	sig := "(" + strings.Join(params, ", ") + ")"
+	switch len(results) {
+	case 0:
+	case 1:
+		sig += " " + results[0]
+	default:
+		sig += " (" + strings.Join(results, ", ") + ")"
+	}
+	return sig
+}
+
+// ResultNames returns r0, r1, ... for the results of the method
+func (m method) ResultNames() string {
+	names := make([]string, len(m.Results))
+	for i := range m.Results {
+		names[i] = "r" + strconv.Itoa(i)
+	}
+	return strings.Join(names, ", ")
+}
+
+// parseInterface returns the methods of the interface iface of the Go file src
+// and the import paths of the packages their types refer to
+func parseInterface(src []byte, iface string) ([]method, []string, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "", src, 0)
+	if err != nil {
+		return nil, nil, err
+	}
+	imports := make(map[string]string)
+	for _, imp := range f.Imports {
+		path, _ := strconv.Unquote(imp.Path.Value)
+		name := path[strings.LastIndex(path, "/")+1:]
+		if imp.Name != nil {
+			name = imp.Name.Name
+		}
+		imports[name] = path
+	}
+
+	var it *ast.InterfaceType
+	ast.Inspect(f, func(n ast.Node) bool {
+		if ts, ok := n.(*ast.TypeSpec); ok && ts.Name.Name == iface {
+			it, _ = ts.Type.(*ast.InterfaceType)
+		}
+		return it == nil
+	})
+	if it == nil {
+		return nil, nil, fmt.Errorf("interface %s not found", iface)
+	}
+
+	used := map[string]bool{goovnPath: true}
+	typeString := func(expr ast.Expr) (string, error) {
+		var err error
+		expr = qualify(expr, func(pkg string) {
+			path, ok := imports[pkg]
+			if !ok {
+				err = fmt.Errorf("unknown package %s", pkg)
+			}
+			used[path] = true
+		})
+		var buf bytes.Buffer
+		if err == nil {
+			err = printer.Fprint(&buf, fset, expr)
+		}
+		return buf.String(), err
+	}
+	fields := func(list *ast.FieldList, prefix string) ([]param, error) {
+		var params []param
+		if list == nil {
+			return nil, nil
+		}
+		for _, field := range list.List {
+			typ, err := typeString(field.Type)
+			if err != nil {
+				return nil, err
+			}
+			_, variadic := field.Type.(*ast.Ellipsis)
+			if variadic {
+				typ = "[]" + strings.TrimPrefix(typ, "...")
+			}
+			names := field.Names
+			if len(names) == 0 {
+				names = []*ast.Ident{nil}
+			}
+			for _, name := range names {
+				p := param{Type: typ, Variadic: variadic}
+				if name != nil {
+					p.Name = name.Name
+				}
+				if p.Name == "" || p.Name == "_" || p.Name == "m" || p.Name == "ret" {
+					p.Name = prefix + strconv.Itoa(len(params))
+				}
+				params = append(params, p)
+			}
+		}
+		return params, nil
+	}
+
+	var methods []method
+	for _, field := range it.Methods.List {
+		ft, ok := field.Type.(*ast.FuncType)
+		if !ok || len(field.Names) != 1 {
+			return nil, nil, fmt.Errorf("embedded interfaces are not supported")
+		}
+		m := method{Name: field.Names[0].Name}
+		if m.Params, err = fields(ft.Params, "p"); err != nil {
+			return nil, nil, err
+		}
+		if m.Results, err = fields(ft.Results, "r"); err != nil {
+			return nil, nil, err
+		}
+		for _, p := range m.Params {
+			if p.Variadic && p.Type == "[]*goovn.OvnCommand" {
+				m.Commands = p.Name
+			}
+		}
+		methods = append(methods, m)
+	}
+	var paths []string
+	for path := range used {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return methods, paths, nil
+}
+
+// qualify returns expr with the types declared in the package of the
+// interface qualified with goovn, pkg is called for every package referred to
+func qualify(expr ast.Expr, pkg func(string)) ast.Expr {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		if ast.IsExported(e.Name) {
+			return &ast.SelectorExpr{X: ast.NewIdent("goovn"), Sel: ast.NewIdent(e.Name)}
+		}
+	case *ast.SelectorExpr:
+		if x, ok := e.X.(*ast.Ident); ok {
+			pkg(x.Name)
+		}
+	case *ast.StarExpr:
+		return &ast.StarExpr{X: qualify(e.X, pkg)}
+	case *ast.ArrayType:
+		return &ast.ArrayType{Len: e.Len, Elt: qualify(e.Elt, pkg)}
+	case *ast.Ellipsis:
+		return &ast.Ellipsis{Elt: qualify(e.Elt, pkg)}
+	case *ast.MapType:
+		return &ast.MapType{Key: qualify(e.Key, pkg), Value: qualify(e.Value, pkg)}
+	case *ast.ChanType:
+		return &ast.ChanType{Dir: e.Dir, Value: qualify(e.Value, pkg)}
+	case *ast.FuncType:
+		qualifyFields(e.Params, pkg)
+		qualifyFields(e.Results, pkg)
+	}
+	return expr
+}
+
+func qualifyFields(list *ast.FieldList, pkg func(string)) {
+	if list == nil {
+		return
+	}
+	for _, field := range list.List {
+		field.Type = qualify(field.Type, pkg)
+	}
+}
+
+var mockTemplate = template.Must(template.New("mock").Parse(`// Code generated by mockgen. DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+{{- range .StdImports}}
+	"{{.}}"
+{{- end}}
+{{range .Imports}}
+	{{if eq . "github.com/ebay/go-ovn"}}goovn {{end}}"{{.}}"
+{{- end}}
+)
+
+var _ goovn.Client = (*Client)(nil)
+{{range .Methods}}
+func (m *Client) {{.Name}}{{.Signature}} {
+{{- if .Executes}}
+	m.record({{.Commands}})
+{{- end}}
+	{{if .Results}}ret := {{end}}m.called("{{.Name}}", []interface{}{ {{- .ArgNames -}} }, {{.Executes}}, func() []interface{} {
+		{{if .Results}}{{.ResultNames}} := {{end}}m.Fallback.{{.Name}}({{.CallArgs}})
+		return []interface{}{ {{- .ResultNames -}} }
+	})
+{{- range $i, $r := .Results}}
+	r{{$i}}, _ := ret.get({{$i}}).({{$r.Type}})
+{{- end}}
+{{- if .ReturnsTransaction}}
+	if r0 == nil {
+		m.noTransaction()
+	}
+{{- end}}
+{{- if .Results}}
+	return {{.ResultNames}}
+{{- end}}
+}
+{{end}}`))
+
+// generate returns the source of a mock implementing the interface iface of
+// the Go file src
+func generate(src []byte, iface, pkg string) ([]byte, error) {
+	methods, imports, err := parseInterface(src, iface)
+	if err != nil {
+		return nil, err
+	}
+	var std, others []string
+	for _, path := range imports {
+		if strings.Contains(strings.Split(path, "/")[0], ".") {
+			others = append(others, path)
+		} else {
+			std = append(std, path)
+		}
+	}
+	var buf bytes.Buffer
+	err = mockTemplate.Execute(&buf, map[string]interface{}{
+		"Package":    pkg,
+		"StdImports": std,
+		"Imports":    others,
+		"Methods":    methods,
+	})
+	if err != nil {
+		return nil, err
+	}
+	out, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated code: %v\n%s", err, buf.Bytes())
+	}
+	return out, nil
+}
@@ -0,0 +1,90 @@
+/**
+ * Copyright (c) 2020 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package main
+
+import (
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testSrc = `package goovn
+
+import (
+	"context"
+
+	"github.com/ebay/libovsdb"
+)
+
+type Client interface {
+	// Get a row
+	Get(ctx context.Context, name string) (*Row, error)
+	Add(ls, lsp string, opts map[string]Option) (*OvnCommand, error)
+	Execute(cmds ...*OvnCommand) error
+	Ops() []libovsdb.Operation
+	NewTransaction() *Transaction
+	Close()
+}
+`
+
+func TestGenerate(t *testing.T) {
+	src, err := generate([]byte(testSrc), "Client", "mock")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = parser.ParseFile(token.NewFileSet(), "mock.go", src, 0)
+	assert.Nil(t, err)
+	code := string(src)
+	for _, s := range []string{
+		`goovn "github.com/ebay/go-ovn"`,
+		`"github.com/ebay/libovsdb"`,
+		"func (m *Client) Get(ctx context.Context, name string) (*goovn.Row, error) {",
+		"func (m *Client) Add(ls string, lsp string, opts map[string]goovn.Option) (*goovn.OvnCommand, error) {",
+		"func (m *Client) Execute(cmds ...*goovn.OvnCommand) error {\n\tm.record(cmds)",
+		`m.called("Execute", []interface{}{cmds}, true,`,
+		"r0 := m.Fallback.Execute(cmds...)",
+		"r0, _ := ret.get(0).([]libovsdb.Operation)",
+		"func (m *Client) Close() {",
+	} {
+		assert.Contains(t, code, s)
+	}
+	assert.Equal(t, 1, strings.Count(code, "m.record("))
+	assert.Contains(t, code, "r0, _ := ret.get(0).(*goovn.Transaction)\n\tif r0 == nil {\n\t\tm.noTransaction()")
+	assert.Equal(t, 1, strings.Count(code, "m.noTransaction()"))
+}
+
+// TestUpToDate fails if goovnmock was not regenerated after a change of the
+// Client interface
+func TestUpToDate(t *testing.T) {
+	src, err := ioutil.ReadFile("../../client.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := generate(src, "Client", "goovnmock")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := ioutil.ReadFile("../../goovnmock/client.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, string(want), string(got), "run go generate ./goovnmock")
+}
@@ -0,0 +1,66 @@
+/**
+ * Copyright (c) 2020 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+// mockgen generates goovnmock.Client, the mock of the Client interface of
+// goovn, from the declaration of the interface.
+//
+//	mockgen -src client.go -out goovnmock/client.go
+//
+// The mock is regenerated with go generate whenever a method is added to the
+// interface:
+//
+//	//go:generate go run ../cmd/mockgen -src ../client.go -out client.go
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+)
+
+func main() {
+	srcFile := flag.String("src", "", "Go file declaring the interface, e.g. client.go")
+	iface := flag.String("interface", "Client", "name of the interface")
+	pkg := flag.String("package", "goovnmock", "package name of the generated code")
+	out := flag.String("out", "", "output file, standard output if empty")
+	flag.Parse()
+
+	if *srcFile == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if err := run(*srcFile, *iface, *pkg, *out); err != nil {
+		fmt.Fprintf(os.Stderr, "mockgen: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(srcFile, iface, pkg, out string) error {
+	src, err := ioutil.ReadFile(srcFile)
+	if err != nil {
+		return err
+	}
+	code, err := generate(src, iface, pkg)
+	if err != nil {
+		return fmt.Errorf("%s: %v", srcFile, err)
+	}
+	if out == "" {
+		_, err = os.Stdout.Write(code)
+		return err
+	}
+	return ioutil.WriteFile(out, code, 0644)
+}
@@ -0,0 +1,319 @@
+/**
+ * Copyright (c) 2020 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"unicode"
+)
+
+// dbSchema is the part of an RFC 7047 <database-schema> modelgen needs
+type dbSchema struct {
+	Name    string                 `json:"name"`
+	Version string                 `json:"version"`
+	Tables  map[string]tableSchema `json:"tables"`
+	raw     []byte
+}
+
+type tableSchema struct {
+	Columns map[string]struct {
+		Type interface{} `json:"type"`
+	} `json:"columns"`
+}
+
+func parseSchema(data []byte) (*dbSchema, error) {
+	var s dbSchema
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, err
+	}
+	if s.Name == "" || len(s.Tables) == 0 {
+		return nil, fmt.Errorf("not a database schema")
+	}
+	s.raw = data
+	return &s, nil
+}
+
+// atomicGoType maps RFC 7047 atomic types to Go types
+var atomicGoType = map[string]string{
+	"integer": "int",
+	"real":    "float64",
+	"boolean": "bool",
+	"string":  "string",
+	"uuid":    "string",
+}
+
+func baseGoType(base interface{}) (string, error) {
+	atomic := ""
+	switch b := base.(type) {
+	case string:
+		atomic = b
+	case map[string]interface{}:
+		atomic, _ = b["type"].(string)
+	}
+	t, ok := atomicGoType[atomic]
+	if !ok {
+		return "", fmt.Errorf("unknown atomic type %v", base)
+	}
+	return t, nil
+}
+
+// goType returns the Go type of a column that the model layer of goovn maps
+// the column to: maps, sets, pointers for optional values and scalars.
+func goType(columnType interface{}) (string, error) {
+	t, ok := columnType.(map[string]interface{})
+	if !ok {
+		return baseGoType(columnType)
+	}
+	key, err := baseGoType(t["key"])
+	if err != nil {
+		return "", err
+	}
+	if value, ok := t["value"]; ok {
+		v, err := baseGoType(value)
+		if err != nil {
+			return "", err
+		}
+		return "map[" + key + "]" + v, nil
+	}
+	min, max := 1, 1
+	if m, ok := t["min"].(float64); ok {
+		min = int(m)
+	}
+	switch m := t["max"].(type) {
+	case float64:
+		max = int(m)
+	case string:
+		max = -1
+	}
+	switch {
+	case max != 1:
+		return "[]" + key, nil
+	case min == 0:
+		return "*" + key, nil
+	}
+	return key, nil
+}
+
+// initialisms are upper cased in Go names, as golint wants
+var initialisms = map[string]string{
+	"acl": "ACL", "acls": "ACLs", "arp": "ARP", "bfd": "BFD", "ca": "CA",
+	"cfg": "Cfg", "dhcp": "DHCP", "dhcpv4": "DHCPv4", "dhcpv6": "DHCPv6",
+	"dns": "DNS", "dp": "DP", "ha": "HA", "id": "ID", "ids": "IDs",
+	"ip": "IP", "ipsec": "IPSec", "lb": "LB", "mac": "MAC", "mtu": "MTU",
+	"nat": "NAT", "qos": "QoS", "ssl": "SSL",
+	"tcp": "TCP", "udp": "UDP", "uuid": "UUID",
+}
+
+// goName converts a table or column name to an exported Go name, e.g.
+// Logical_Switch to LogicalSwitch and external_ids to ExternalIDs
+func goName(name string) string {
+	var sb strings.Builder
+	for _, part := range strings.FieldsFunc(name, func(r rune) bool {
+		return r == '_' || r == '-' || r == ':'
+	}) {
+		if s, ok := initialisms[strings.ToLower(part)]; ok && strings.ToLower(part) == part {
+			sb.WriteString(s)
+			continue
+		}
+		runes := []rune(part)
+		runes[0] = unicode.ToUpper(runes[0])
+		sb.WriteString(string(runes))
+	}
+	name = sb.String()
+	if name == "" || !unicode.IsLetter([]rune(name)[0]) {
+		name = "X" + name
+	}
+	return name
+}
+
+type genField struct {
+	Name   string
+	Type   string
+	Column string
+}
+
+type genTable struct {
+	Name   string
+	GoName string
+	Fields []genField
+}
+
+type genData struct {
+	Package string
+	Schema  string
+	Version string
+	Raw     string // Go literal of the schema file
+	Tables  []genTable
+}
+
+// rawLiteral returns a Go string literal of the schema file
+func rawLiteral(data []byte) string {
+	if bytes.ContainsRune(data, '`') {
+		return strconv.Quote(string(data))
+	}
+	return "`" + string(data) + "`"
+}
+
+// generate returns the Go source of the models, table constants and
+// commands of the tables of schema
+func generate(s *dbSchema, pkg string) ([]byte, error) {
+	data := genData{Package: pkg, Schema: s.Name, Version: s.Version, Raw: rawLiteral(s.raw)}
+	for name, table := range s.Tables {
+		t := genTable{Name: name, GoName: goName(name)}
+		names := map[string]bool{"UUID": true}
+		for column, c := range table.Columns {
+			typ, err := goType(c.Type)
+			if err != nil {
+				return nil, fmt.Errorf("table %s column %s: %v", name, column, err)
+			}
+			field := goName(column)
+			for names[field] {
+				field += "_"
+			}
+			names[field] = true
+			t.Fields = append(t.Fields, genField{field, typ, column})
+		}
+		sort.Slice(t.Fields, func(i, j int) bool { return t.Fields[i].Column < t.Fields[j].Column })
+		data.Tables = append(data.Tables, t)
+	}
+	sort.Slice(data.Tables, func(i, j int) bool { return data.Tables[i].Name < data.Tables[j].Name })
+
+	return execute(modelTemplate, data)
+}
+
+// generateTables returns the Go source of the table constants of all the
+// tables of schemas, for the table constants of goovn
+func generateTables(schemas []*dbSchema, pkg string) ([]byte, error) {
+	var names []string
+	data := genData{Package: pkg}
+	seen := make(map[string]bool)
+	for _, s := range schemas {
+		names = append(names, s.Name)
+		for name := range s.Tables {
+			if !seen[name] {
+				seen[name] = true
+				data.Tables = append(data.Tables, genTable{Name: name, GoName: goName(name)})
+			}
+		}
+	}
+	sort.Slice(data.Tables, func(i, j int) bool { return data.Tables[i].Name < data.Tables[j].Name })
+	data.Schema = strings.Join(names, " and ")
+	return execute(tablesTemplate, data)
+}
+
+func execute(tmpl *template.Template, data genData) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return nil, err
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated code: %v", err)
+	}
+	return src, nil
+}
+
+var tablesTemplate = template.Must(template.New("tables").Parse(`// Code generated by modelgen from the {{.Schema}} schemas. DO NOT EDIT.
+
+package {{.Package}}
+
+// Tables of {{.Schema}}
+const (
+{{- range .Tables}}
+	Table{{.GoName}} string = "{{.Name}}"
+{{- end}}
+)
+`))
+
+var modelTemplate = template.Must(template.New("model").Parse(`// Code generated by modelgen from the {{.Schema}} schema version {{.Version}}. DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+	goovn "github.com/ebay/go-ovn"
+)
+
+// SchemaVersion is the version of the {{.Schema}} schema the models were generated from
+const SchemaVersion = "{{.Version}}"
+
+// Schema is the {{.Schema}} schema the models were generated from
+const Schema = {{.Raw}}
+
+// Tables of {{.Schema}}
+const (
+{{- range .Tables}}
+	Table{{.GoName}} = "{{.Name}}"
+{{- end}}
+)
+
+// Models returns a model of every table of {{.Schema}} for goovn.Config.Models
+func Models() map[string]interface{} {
+	return map[string]interface{}{
+{{- range .Tables}}
+		Table{{.GoName}}: &{{.GoName}}{},
+{{- end}}
+	}
+}
+{{range .Tables}}
+// {{.GoName}} is a row of the {{.Name}} table
+type {{.GoName}} struct {
+	UUID string ` + "`" + `ovsdb:"_uuid"` + "`" + `
+{{- range .Fields}}
+	{{.Name}} {{.Type}} ` + "`" + `ovsdb:"{{.Column}}"` + "`" + `
+{{- end}}
+}
+
+// Get{{.GoName}} returns the {{.Name}} row with uuid
+func Get{{.GoName}}(c goovn.Client, uuid string) (*{{.GoName}}, error) {
+	m := &{{.GoName}}{}
+	if err := c.ModelGet(uuid, m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// List{{.GoName}} returns all {{.Name}} rows
+func List{{.GoName}}(c goovn.Client) ([]*{{.GoName}}, error) {
+	var list []*{{.GoName}}
+	if err := c.ModelList(&list); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// Create{{.GoName}} inserts m into {{.Name}}
+func Create{{.GoName}}(c goovn.Client, m *{{.GoName}}) (*goovn.OvnCommand, error) {
+	return c.ModelAdd(m)
+}
+
+// Update{{.GoName}} updates the columns of the {{.Name}} row of m, all if none given
+func Update{{.GoName}}(c goovn.Client, m *{{.GoName}}, columns ...string) (*goovn.OvnCommand, error) {
+	return c.ModelUpdate(m, columns...)
+}
+
+// Delete{{.GoName}} deletes the {{.Name}} row of m
+func Delete{{.GoName}}(c goovn.Client, m *{{.GoName}}) (*goovn.OvnCommand, error) {
+	return c.ModelDel(m)
+}
+{{end}}`))
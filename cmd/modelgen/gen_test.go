@@ -0,0 +1,122 @@
+/**
+ * Copyright (c) 2020 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package main
+
+import (
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGoName(t *testing.T) {
+	assert.Equal(t, "LogicalSwitch", goName("Logical_Switch"))
+	assert.Equal(t, "NBGlobal", goName("NB_Global"))
+	assert.Equal(t, "DHCPOptions", goName("DHCP_Options"))
+	assert.Equal(t, "ExternalIDs", goName("external_ids"))
+	assert.Equal(t, "DHCPv4Options", goName("dhcpv4_options"))
+	assert.Equal(t, "NbCfg", goName("nb_cfg"))
+}
+
+func TestGenerate(t *testing.T) {
+	data, err := ioutil.ReadFile("testdata/test.ovsschema")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := parseSchema(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	src, err := generate(s, "nb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = parser.ParseFile(token.NewFileSet(), "model.go", src, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []string{
+		`TableLogicalSwitchPort = "Logical_Switch_Port"`,
+		"type LogicalSwitch struct {",
+		"Ports       []string          `ovsdb:\"ports\"`",
+		"ExternalIDs map[string]string `ovsdb:\"external_ids\"`",
+		"Tag           *int     `ovsdb:\"tag\"`",
+		"DHCPv4Options *string  `ovsdb:\"dhcpv4_options\"`",
+		"Enabled       *bool    `ovsdb:\"enabled\"`",
+		"NbCfg       int               `ovsdb:\"nb_cfg\"`",
+		"func GetNBGlobal(c goovn.Client, uuid string) (*NBGlobal, error) {",
+		"func ListLogicalSwitchPort(c goovn.Client) ([]*LogicalSwitchPort, error) {",
+		"TableLogicalSwitch:     &LogicalSwitch{},",
+		"const Schema = `{",
+	} {
+		assert.Contains(t, string(src), s)
+	}
+}
+
+func TestGenerateTables(t *testing.T) {
+	schemas := []*dbSchema{
+		{Name: "A", Tables: map[string]tableSchema{"Logical_Switch": {}, "SSL": {}}},
+		{Name: "B", Tables: map[string]tableSchema{"SSL": {}, "Chassis": {}}},
+	}
+	src, err := generateTables(schemas, "goovn")
+	if err != nil {
+		t.Fatal(err)
+	}
+	code := string(src)
+	assert.Contains(t, code, "// Tables of A and B")
+	assert.Contains(t, code, "\tTableChassis       string = \"Chassis\"\n\tTableLogicalSwitch string = \"Logical_Switch\"\n\tTableSSL           string = \"SSL\"\n")
+}
+
+// TestUpToDate fails if the table constants of goovn or the models were not
+// regenerated after a change of the pinned schemas
+func TestUpToDate(t *testing.T) {
+	var schemas []*dbSchema
+	for _, m := range []struct{ pkg, schema string }{
+		{"nb", "../../model/nb/ovn-nb.ovsschema"},
+		{"sb", "../../model/sb/ovn-sb.ovsschema"},
+	} {
+		data, err := ioutil.ReadFile(m.schema)
+		if err != nil {
+			t.Fatal(err)
+		}
+		s, err := parseSchema(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		schemas = append(schemas, s)
+		want, err := generate(s, m.pkg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := ioutil.ReadFile("../../model/" + m.pkg + "/model.go")
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, string(want), string(got), "run go generate in the root of go-ovn")
+	}
+	want, err := generateTables(schemas, "goovn")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := ioutil.ReadFile("../../tables.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, string(want), string(got), "run go generate in the root of go-ovn")
+}
@@ -0,0 +1,94 @@
+/**
+ * Copyright (c) 2020 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+// modelgen generates the models of the tables of an OVSDB schema for the
+// model layer of goovn, with table constants and commands to get, list,
+// create, update and delete rows.
+//
+//	modelgen -schema ovn-nb.ovsschema -package nb -out model.go
+//
+// A package of generated models is regenerated with go generate, e.g.
+//
+//	//go:generate go run github.com/ebay/go-ovn/cmd/modelgen -schema ovn-nb.ovsschema -package nb -out model.go
+//
+// With -tables only the table constants of all the tables of a comma
+// separated list of schemas are generated, as for the table constants of
+// goovn:
+//
+//	modelgen -tables -schema ovn-nb.ovsschema,ovn-sb.ovsschema -package goovn -out tables.go
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func main() {
+	schemaFile := flag.String("schema", "", "OVSDB schema file, e.g. ovn-nb.ovsschema, comma separated files with -tables")
+	pkg := flag.String("package", "", "package name of the generated code")
+	out := flag.String("out", "", "output file, standard output if empty")
+	tables := flag.Bool("tables", false, "only generate the table constants of the schemas")
+	flag.Parse()
+
+	if *schemaFile == "" || *pkg == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if err := run(strings.Split(*schemaFile, ","), *pkg, *out, *tables); err != nil {
+		fmt.Fprintf(os.Stderr, "modelgen: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(schemaFiles []string, pkg, out string, tables bool) error {
+	if len(schemaFiles) > 1 && !tables {
+		return fmt.Errorf("several schemas are only supported with -tables")
+	}
+	var schemas []*dbSchema
+	for _, schemaFile := range schemaFiles {
+		data, err := ioutil.ReadFile(schemaFile)
+		if err != nil {
+			return err
+		}
+		s, err := parseSchema(data)
+		if err != nil {
+			return fmt.Errorf("%s: %v", schemaFile, err)
+		}
+		schemas = append(schemas, s)
+	}
+	var src []byte
+	var err error
+	if tables {
+		src, err = generateTables(schemas, pkg)
+	} else {
+		src, err = generate(schemas[0], pkg)
+	}
+	if err != nil {
+		return err
+	}
+	if out == "" {
+		_, err = os.Stdout.Write(src)
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(out), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(out, src, 0644)
+}
@@ -0,0 +1,114 @@
+/**
+ * Copyright (c) 2020 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn
+
+import (
+	"fmt"
+
+	"github.com/ebay/libovsdb"
+)
+
+// PopulatedColumns is embedded in the typed objects of the tables to report
+// the columns their fields were read from. It is nil if all columns of the
+// table are monitored, see Config.TableCols.
+type PopulatedColumns map[string]bool
+
+// IsPopulated reports whether column was monitored. The fields of columns
+// that are not monitored hold their zero value.
+func (p PopulatedColumns) IsPopulated(column string) bool {
+	return p == nil || p[column]
+}
+
+// populatedColumns validates the monitored columns of tableCols against the
+// schema and returns the PopulatedColumns of the tables with column lists.
+func populatedColumns(schema libovsdb.DatabaseSchema, tableCols map[string][]string) (map[string]PopulatedColumns, error) {
+	populated := make(map[string]PopulatedColumns)
+	for table, columns := range tableCols {
+		if len(columns) == 0 {
+			continue
+		}
+		tableSchema := schema.Tables[table]
+		populated[table] = make(PopulatedColumns, len(columns))
+		for _, column := range columns {
+			if _, ok := tableSchema.Columns[column]; !ok {
+				return nil, fmt.Errorf("specified column %q of table %q not found in schema", column, table)
+			}
+			populated[table][column] = true
+		}
+	}
+	return populated, nil
+}
+
+// rowString returns the string value of column, or "" if row has no such
+// column or it holds an empty optional value
+func rowString(row libovsdb.Row, column string) string {
+	v, _ := row.Fields[column].(string)
+	return v
+}
+
+// rowInt returns the integer value of column, or 0 if row has no such column
+func rowInt(row libovsdb.Row, column string) int {
+	v, _ := row.Fields[column].(int)
+	return v
+}
+
+// rowOptionalInt returns the value of an optional integer column, or nil if
+// row has no such column or the value is empty
+func rowOptionalInt(row libovsdb.Row, column string) *int {
+	if v, ok := row.Fields[column].(int); ok {
+		return &v
+	}
+	return nil
+}
+
+// rowBool returns the boolean value of column, or false if row has no such
+// column
+func rowBool(row libovsdb.Row, column string) bool {
+	v, _ := row.Fields[column].(bool)
+	return v
+}
+
+// rowMap returns the map value of column, or nil if row has no such column
+func rowMap(row libovsdb.Row, column string) map[interface{}]interface{} {
+	if v, ok := row.Fields[column].(libovsdb.OvsMap); ok {
+		return v.GoMap
+	}
+	return nil
+}
+
+// rowSet returns the string or uuid elements of a set column, or nil if row
+// has no such column
+func rowSet(row libovsdb.Row, column string) []string {
+	switch v := row.Fields[column].(type) {
+	case string:
+		return []string{v}
+	case libovsdb.UUID:
+		return []string{v.GoUUID}
+	case libovsdb.OvsSet:
+		var elems []string
+		for _, e := range v.GoSet {
+			switch e := e.(type) {
+			case string:
+				elems = append(elems, e)
+			case libovsdb.UUID:
+				elems = append(elems, e.GoUUID)
+			}
+		}
+		return elems
+	}
+	return nil
+}
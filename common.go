@@ -22,39 +22,19 @@ const (
 	opDelete string = "delete"
 	opSelect string = "select"
 	opUpdate string = "update"
+	opWait   string = "wait"
 )
 
 const (
 	DBNB string = "OVN_Northbound"
 	DBSB string = "OVN_Southbound"
+	// DBServer is the db of ovsdb-server reporting the state of its dbs
+	DBServer string = "_Server"
 )
 
-const (
-	TableNBGlobal                 string = "NB_Global"
-	TableLogicalSwitch            string = "Logical_Switch"
-	TableLogicalSwitchPort        string = "Logical_Switch_Port"
-	TableAddressSet               string = "Address_Set"
-	TablePortGroup                string = "Port_Group"
-	TableLoadBalancer             string = "Load_Balancer"
-	TableACL                      string = "ACL"
-	TableLogicalRouter            string = "Logical_Router"
-	TableQoS                      string = "QoS"
-	TableMeter                    string = "Meter"
-	TableMeterBand                string = "Meter_Band"
-	TableLogicalRouterPort        string = "Logical_Router_Port"
-	TableLogicalRouterStaticRoute string = "Logical_Router_Static_Route"
-	TableLogicalRouterPolicy      string = "Logical_Router_Policy"
-	TableNAT                      string = "NAT"
-	TableDHCPOptions              string = "DHCP_Options"
-	TableConnection               string = "Connection"
-	TableDNS                      string = "DNS"
-	TableSSL                      string = "SSL"
-	TableGatewayChassis           string = "Gateway_Chassis"
-	TableChassis                  string = "Chassis"
-	TableEncap                    string = "Encap"
-	TableSBGlobal                 string = "SB_Global"
-	TableChassisPrivate           string = "Chassis_Private"
-)
+// TableServerDatabase is the table of the _Server db, the tables of the OVN
+// dbs are generated in tables.go
+const TableServerDatabase string = "Database"
 
 var NBTablesOrder = []string{
 	TableNBGlobal,
@@ -74,6 +54,8 @@ var NBTablesOrder = []string{
 	TableDNS,
 	TableSSL,
 	TableGatewayChassis,
+	TableHAChassis,
+	TableHAChassisGroup,
 	TablePortGroup,
 	TableLogicalSwitch,
 	TableLogicalRouter,
@@ -83,5 +65,16 @@ var SBTablesOrder = []string{
 	TableChassis,
 	TableChassisPrivate,
 	TableEncap,
+	TableConnection,
+	TableSSL,
 	TableSBGlobal,
+	TablePortBinding,
+}
+
+// SBOptInTables are supported but not monitored unless they are in
+// Config.TableCols, caching every logical flow is costly
+var SBOptInTables = []string{
+	TableDatapathBinding,
+	TableLogicalFlow,
+	TableLogicalDPGroup,
 }
@@ -18,15 +18,45 @@ package goovn
 
 import (
 	"crypto/tls"
+	"time"
 )
 
 // Config ovn nb and sb db client config
 type Config struct {
 	Db           string
-	Addr         string
+	Addr         string // Comma-separated list of remotes, e.g. the servers of a cluster
 	TLSConfig    *tls.Config
 	SignalCB     OVNSignal
-	DisconnectCB OVNDisconnectedCallback // Callback that is called when disconnected, if "Reconnect" is false.
+	DisconnectCB OVNDisconnectedCallback // Callback that is called when disconnected, if "Reconnect" and "LeaderOnly" are false.
 	Reconnect    bool                    // Automatically reconnect when disconnected
-	TableCols    map[string][]string     // List of tables and their cols to be monitored
+	// Backoff and max attempts of automatic reconnection
+	ReconnectPolicy ReconnectPolicy
+	OnConnected     OVNConnectedCallback    // Callback that is called when connected by NewClient
+	OnReconnecting  OVNReconnectingCallback // Callback that is called before each reconnection attempt
+	OnReconnected   OVNReconnectedCallback  // Callback that is called when reconnected
+	LeaderOnly      bool                    // Only connect to the leader of a clustered db and reconnect when it loses leadership, implies Reconnect
+	TableCols       map[string][]string     // List of tables and their cols to be monitored, all cols if empty
+	// Max number of events waiting for SignalCB and event handlers,
+	// DefaultEventQueueDepth if 0
+	EventQueueDepth int
+	// What to do with events when the event queue is full, the oldest event
+	// is dropped by default. EventOverflowBlock stalls the client while the
+	// queue is full, see its deadlock risk.
+	EventQueueOverflow EventOverflowPolicy
+	// Logger of the client, if nil only errors are logged with the standard
+	// log package
+	Logger Logger
+	// Logger of every operation sent and row update received, e.g. the
+	// V(1) level of a logr.Logger, nothing is logged if nil
+	DebugLogger Logger
+	// Receives transaction, cache and reconnection metrics if set
+	Metrics Metrics
+	// Structs with `ovsdb:"column"` field tags by table, e.g.
+	// {"Logical_Switch": &MySwitch{}}, for ModelGet, ModelList, ModelAdd,
+	// ModelUpdate and ModelDel. Models are validated against the schema by
+	// NewClient and their tables are monitored.
+	Models map[string]interface{}
+	// Timeout of the requests to the db server whose context has no
+	// deadline, including those of Execute, no timeout if 0
+	Timeout time.Duration
 }
@@ -0,0 +1,160 @@
+/**
+ * Copyright (c) 2020 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn
+
+import (
+	"fmt"
+
+	"github.com/ebay/libovsdb"
+)
+
+// Connection ovnnb/ovnsb item, a remote the db server listens on or connects to
+type Connection struct {
+	UUID            string
+	Target          string
+	InactivityProbe *int // milliseconds, 0 disables the probe, server default if nil
+	MaxBackoff      *int // milliseconds, server default if nil
+	Role            string
+	OtherConfig     map[interface{}]interface{}
+	ExternalID      map[interface{}]interface{}
+	Status          map[interface{}]interface{}
+	IsConnected     bool
+	PopulatedColumns
+}
+
+func (odbi *ovndb) connectionRow(conn *Connection) (OVNRow, error) {
+	if conn == nil || conn.Target == "" {
+		return nil, fmt.Errorf("connection target is required")
+	}
+	row := make(OVNRow)
+	row["target"] = conn.Target
+	if conn.InactivityProbe != nil {
+		row["inactivity_probe"] = *conn.InactivityProbe
+	}
+	if conn.MaxBackoff != nil {
+		row["max_backoff"] = *conn.MaxBackoff
+	}
+	if conn.Role != "" {
+		// only the southbound db has RBAC roles
+		if _, ok := odbi.tableColumnTypes(TableConnection)["role"]; !ok {
+			return nil, ErrorSchema
+		}
+		row["role"] = conn.Role
+	}
+	if conn.OtherConfig != nil {
+		oMap, err := libovsdb.NewOvsMap(conn.OtherConfig)
+		if err != nil {
+			return nil, err
+		}
+		row["other_config"] = oMap
+	}
+	if conn.ExternalID != nil {
+		oMap, err := libovsdb.NewOvsMap(conn.ExternalID)
+		if err != nil {
+			return nil, err
+		}
+		row["external_ids"] = oMap
+	}
+	return row, nil
+}
+
+// Replace the connections of the global table with conns, like ovn-nbctl
+// set-connection. The previous connection rows are garbage collected.
+func (odbi *ovndb) connectionSetImp(conns ...*Connection) (*OvnCommand, error) {
+	var operations []libovsdb.Operation
+	var refs []libovsdb.UUID
+	for _, conn := range conns {
+		row, err := odbi.connectionRow(conn)
+		if err != nil {
+			return nil, err
+		}
+		namedUUID, err := newRowUUID()
+		if err != nil {
+			return nil, err
+		}
+		operations = append(operations, libovsdb.Operation{
+			Op:       opInsert,
+			Table:    TableConnection,
+			Row:      row,
+			UUIDName: namedUUID,
+		})
+		refs = append(refs, stringToGoUUID(namedUUID))
+	}
+	refSet, err := libovsdb.NewOvsSet(refs)
+	if err != nil {
+		return nil, err
+	}
+	if refSet.GoSet == nil {
+		refSet.GoSet = []interface{}{}
+	}
+	updateOp, err := odbi.globalUpdateOp("connections", refSet)
+	if err != nil {
+		return nil, err
+	}
+	operations = append(operations, updateOp)
+	return &OvnCommand{operations, odbi, make([][]map[string]interface{}, len(operations))}, nil
+}
+
+// Remove all connections of the global table, like ovn-nbctl del-connection
+func (odbi *ovndb) connectionDelImp() (*OvnCommand, error) {
+	return odbi.connectionSetImp()
+}
+
+// Get the connections of the global table
+func (odbi *ovndb) connectionGetImp() ([]*Connection, error) {
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+
+	if _, ok := odbi.tableCols[odbi.globalTable()]; !ok {
+		return nil, ErrorSchema
+	}
+	// tables without rows are not in the cache
+	cacheGlobal := odbi.cache[odbi.globalTable()]
+	if len(cacheGlobal) == 0 {
+		return nil, ErrorNotFound
+	}
+	var listConn []*Connection
+	for _, drows := range cacheGlobal {
+		for _, uuid := range rowSet(drows, "connections") {
+			if conn := odbi.rowToConnection(uuid); conn != nil {
+				listConn = append(listConn, conn)
+			}
+		}
+	}
+	return listConn, nil
+}
+
+func (odbi *ovndb) rowToConnection(uuid string) *Connection {
+	cacheConn, ok := odbi.cache[TableConnection][uuid]
+	if !ok {
+		return nil
+	}
+
+	conn := &Connection{
+		UUID:            uuid,
+		Target:          rowString(cacheConn, "target"),
+		InactivityProbe: rowOptionalInt(cacheConn, "inactivity_probe"),
+		MaxBackoff:      rowOptionalInt(cacheConn, "max_backoff"),
+		Role:            rowString(cacheConn, "role"),
+		OtherConfig:     rowMap(cacheConn, "other_config"),
+		ExternalID:      rowMap(cacheConn, "external_ids"),
+		Status:          rowMap(cacheConn, "status"),
+		IsConnected:     rowBool(cacheConn, "is_connected"),
+	}
+	conn.PopulatedColumns = odbi.populated[TableConnection]
+	return conn
+}
@@ -0,0 +1,141 @@
+/**
+ * Copyright (c) 2020 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const CONN_TARGET = "ptcp:0:127.0.0.1"
+
+func TestConnection(t *testing.T) {
+	for _, db := range []string{DBNB, DBSB} {
+		ovndbapi := getOVNClient(db)
+
+		saved, err := ovndbapi.ConnectionGet()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		t.Logf("Setting connection %s of %s", CONN_TARGET, db)
+		probe := 0
+		cmd, err := ovndbapi.ConnectionSet(&Connection{
+			Target:          CONN_TARGET,
+			InactivityProbe: &probe,
+			ExternalID:      map[interface{}]interface{}{FOO: BAR},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = ovndbapi.Execute(cmd)
+		if err != nil {
+			t.Fatal(err)
+		}
+		conns, err := ovndbapi.ConnectionGet()
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, 1, len(conns))
+		assert.Equal(t, CONN_TARGET, conns[0].Target)
+		if assert.NotNil(t, conns[0].InactivityProbe) {
+			assert.Equal(t, 0, *conns[0].InactivityProbe)
+		}
+		assert.Nil(t, conns[0].MaxBackoff)
+		assert.Equal(t, BAR, conns[0].ExternalID[FOO])
+
+		_, err = ovndbapi.ConnectionSet(&Connection{Target: CONN_TARGET, Role: "ovn-controller"})
+		if db == DBNB {
+			assert.Equal(t, ErrorSchema, err)
+		} else {
+			assert.Nil(t, err)
+		}
+
+		cmd, err = ovndbapi.ConnectionDel()
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = ovndbapi.Execute(cmd)
+		if err != nil {
+			t.Fatal(err)
+		}
+		conns, err = ovndbapi.ConnectionGet()
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, 0, len(conns))
+
+		cmd, err = ovndbapi.ConnectionSet(saved...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = ovndbapi.Execute(cmd)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ovndbapi.Close()
+	}
+}
+
+func TestSSL(t *testing.T) {
+	ovndbapi := getOVNClient(DBNB)
+	defer ovndbapi.Close()
+
+	saved, err := ovndbapi.SSLGet()
+	if err != nil && err != ErrorNotFound {
+		t.Fatal(err)
+	}
+
+	_, err = ovndbapi.SSLSet(&SSL{PrivateKey: defaultClientPrivKey})
+	assert.Error(t, err)
+
+	t.Logf("Setting SSL configuration")
+	cmd, err := ovndbapi.SSLSet(&SSL{
+		PrivateKey:   defaultClientPrivKey,
+		Certificate:  defaultClientCACert,
+		CACert:       defaultClientCACert,
+		SSLProtocols: "TLSv1.2",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ssl, err := ovndbapi.SSLGet()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, defaultClientPrivKey, ssl.PrivateKey)
+	assert.Equal(t, "TLSv1.2", ssl.SSLProtocols)
+	assert.False(t, ssl.BootstrapCACert)
+
+	if saved != nil {
+		cmd, err = ovndbapi.SSLSet(saved)
+	} else {
+		cmd, err = ovndbapi.SSLDel()
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
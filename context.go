@@ -0,0 +1,140 @@
+/**
+ * Copyright (c) 2020 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/ebay/go-ovn/internal/transact"
+	"github.com/ebay/libovsdb"
+)
+
+// requestContext applies the default request timeout of the client to ctx
+// if it has no deadline
+func (odbi *ovndb) requestContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if _, ok := ctx.Deadline(); ok || odbi.timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, odbi.timeout)
+}
+
+// transactRaw sends a transact request and returns its reply unchecked. The
+// rpc layer of libovsdb cannot cancel a request, so if ctx is done first the
+// reply is dropped when it arrives and ctx.Err() is returned.
+func (odbi *ovndb) transactRaw(ctx context.Context, db string, ops ...libovsdb.Operation) ([]libovsdb.OperationResult, error) {
+	ctx, cancel := odbi.requestContext(ctx)
+	defer cancel()
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	type result struct {
+		reply []libovsdb.OperationResult
+		err   error
+	}
+	done := make(chan result, 1)
+	send := odbi.client.Transact
+	if f, _ := odbi.transactFunc.Load().(transact.Func); f != nil {
+		send = func(db string, ops ...libovsdb.Operation) ([]libovsdb.OperationResult, error) {
+			return f(db, ops)
+		}
+	}
+	odbi.debug("transact", "db", db, "operations", ops)
+	start := time.Now()
+	go func() {
+		reply, err := send(db, ops...)
+		done <- result{reply, err}
+	}()
+	select {
+	case r := <-done:
+		odbi.debug("transact reply", "db", db, "reply", r.reply, "error", r.err)
+		odbi.observeTransact(db, ops, transactOutcome(r.reply, r.err), time.Since(start))
+		return r.reply, r.err
+	case <-ctx.Done():
+		odbi.debug("transact abandoned", "db", db, "error", ctx.Err())
+		odbi.observeTransact(db, ops, TransactOutcomeTimeout, time.Since(start))
+		return nil, ctx.Err()
+	}
+}
+
+func init() {
+	transact.SetFunc = setTransactFunc
+}
+
+// setTransactFunc replaces the transact requests of c with f, goovnmock
+// records the writes of a client with it, see transact.SetFunc
+func setTransactFunc(c interface{}, f transact.Func) error {
+	odbi, ok := c.(*ovndb)
+	if !ok {
+		return ErrorOption
+	}
+	odbi.transactFunc.Store(f)
+	return nil
+}
+
+func (odbi *ovndb) transactContext(ctx context.Context, db string, ops ...libovsdb.Operation) ([]libovsdb.OperationResult, error) {
+	reply, err := odbi.transactRaw(ctx, db, ops...)
+	if err != nil {
+		return reply, err
+	}
+	return checkOperationResults(reply, ops)
+}
+
+// readContext runs the cache read f unless ctx is done, and returns
+// ctx.Err() instead of its result if ctx is done once f returns. f is not
+// interrupted, a read that waits for the cache while it is locked, e.g. during
+// the initial dump after a reconnection, fails only after getting the lock.
+func readContext(ctx context.Context, f func() (interface{}, error)) (interface{}, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	res, err := f()
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return nil, ctxErr
+	}
+	return res, err
+}
+
+// connectContext connects the client, if ctx is done first the connection
+// is closed as soon as it is established and ctx.Err() is returned.
+func connectContext(ctx context.Context, c *ovndb) error {
+	if ctx.Done() == nil {
+		return connect(c)
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	done := make(chan error, 1)
+	go func() {
+		done <- connect(c)
+	}()
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		// no reconnection once the abandoned connection is closed
+		atomic.StoreInt32(&c.closed, 1)
+		go func() {
+			if err := <-done; err == nil {
+				c.client.Disconnect()
+			}
+		}()
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,94 @@
+/**
+ * Copyright (c) 2020 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ebay/libovsdb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadContext(t *testing.T) {
+	odbi := &ovndb{cache: make(map[string]map[string]libovsdb.Row)}
+	odbi.cache[TableLogicalSwitch] = make(map[string]libovsdb.Row)
+
+	ls, err := odbi.LSListContext(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(ls))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = odbi.LSListContext(ctx)
+	assert.Equal(t, context.Canceled, err)
+
+	// the cache is locked as during the initial dump of a reconnection, the
+	// read fails once it gets the lock after the deadline
+	odbi.cachemutex.Lock()
+	ctx, cancel = context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	time.AfterFunc(100*time.Millisecond, odbi.cachemutex.Unlock)
+	_, err = odbi.LSListContext(ctx)
+	assert.Equal(t, context.DeadlineExceeded, err)
+}
+
+func TestNewClientContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := NewClientContext(ctx, &Config{Addr: "unix:/nonexistent/ovnnb_db.sock"})
+	assert.Equal(t, context.Canceled, err)
+}
+
+func TestExecuteContext(t *testing.T) {
+	cfg := buildOvnDbConfig(DBNB)
+	cfg.Timeout = 10 * time.Second
+	ovndbapi, err := NewClientContext(context.Background(), cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ovndbapi.Close()
+
+	cmd, err := ovndbapi.LSAdd(LSW)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = ovndbapi.ExecuteContext(ctx, cmd)
+	assert.Equal(t, context.Canceled, err)
+
+	err = ovndbapi.ExecuteContext(context.Background(), cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ls, err := ovndbapi.LSGetContext(context.Background(), LSW)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 1, len(ls))
+
+	cmd, err = ovndbapi.LSDel(LSW)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.ExecuteContext(context.Background(), cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,124 @@
+/**
+ * Copyright (c) 2020 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn
+
+import (
+	"fmt"
+
+	"github.com/ebay/libovsdb"
+)
+
+// external_ids keys set by ovn-northd on Datapath_Binding rows
+const (
+	DatapathExtIDName          = "name"
+	DatapathExtIDLogicalSwitch = "logical-switch"
+	DatapathExtIDLogicalRouter = "logical-router"
+)
+
+// DatapathBinding table OVN SB
+type DatapathBinding struct {
+	UUID       string
+	TunnelKey  int
+	ExternalID map[interface{}]interface{}
+	PopulatedColumns
+}
+
+func (odbi *ovndb) datapathBindingListImp() ([]*DatapathBinding, error) {
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+
+	cacheDatapathBinding, ok := odbi.cache[TableDatapathBinding]
+	if !ok {
+		return nil, ErrorSchema
+	}
+
+	listDatapathBinding := make([]*DatapathBinding, 0, len(cacheDatapathBinding))
+	for uuid := range cacheDatapathBinding {
+		dp, err := odbi.rowToDatapathBinding(uuid)
+		if err != nil {
+			return nil, err
+		}
+		listDatapathBinding = append(listDatapathBinding, dp)
+	}
+	return listDatapathBinding, nil
+}
+
+// Get datapath bindings by the name or UUID of their NB logical switch or router
+func (odbi *ovndb) datapathBindingGetImp(name string) ([]*DatapathBinding, error) {
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+
+	if _, ok := odbi.cache[TableDatapathBinding]; !ok {
+		return nil, ErrorSchema
+	}
+
+	var listDatapathBinding []*DatapathBinding
+	for _, uuid := range odbi.datapathUUIDs(name) {
+		dp, err := odbi.rowToDatapathBinding(uuid)
+		if err != nil {
+			return nil, err
+		}
+		listDatapathBinding = append(listDatapathBinding, dp)
+	}
+	if len(listDatapathBinding) == 0 {
+		return nil, ErrorNotFound
+	}
+	return listDatapathBinding, nil
+}
+
+// datapathUUIDs resolves the name or NB UUID of a logical switch or router,
+// or the UUID of a Datapath_Binding row, to Datapath_Binding UUIDs.
+// The cache must be locked by the caller.
+func (odbi *ovndb) datapathUUIDs(name string) []string {
+	var uuids []string
+	for uuid, drows := range odbi.cache[TableDatapathBinding] {
+		if uuid == name {
+			uuids = append(uuids, uuid)
+			continue
+		}
+		extIds, ok := drows.Fields["external_ids"].(libovsdb.OvsMap)
+		if !ok {
+			continue
+		}
+		for _, key := range []string{DatapathExtIDName, DatapathExtIDLogicalSwitch, DatapathExtIDLogicalRouter} {
+			if v, ok := extIds.GoMap[key]; ok && v == name {
+				uuids = append(uuids, uuid)
+				break
+			}
+		}
+	}
+	return uuids
+}
+
+func (odbi *ovndb) rowToDatapathBinding(uuid string) (*DatapathBinding, error) {
+	cacheDatapathBinding, ok := odbi.cache[TableDatapathBinding][uuid]
+	if !ok {
+		return nil, fmt.Errorf("Datapath binding with uuid %s not found", uuid)
+	}
+
+	dp := &DatapathBinding{
+		UUID: uuid,
+	}
+	if key, ok := cacheDatapathBinding.Fields["tunnel_key"].(int); ok {
+		dp.TunnelKey = key
+	}
+	if extIds, ok := cacheDatapathBinding.Fields["external_ids"].(libovsdb.OvsMap); ok {
+		dp.ExternalID = extIds.GoMap
+	}
+	dp.PopulatedColumns = odbi.populated[TableDatapathBinding]
+	return dp, nil
+}
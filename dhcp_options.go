@@ -26,6 +26,7 @@ type DHCPOptions struct {
 	CIDR       string
 	Options    map[interface{}]interface{}
 	ExternalID map[interface{}]interface{}
+	PopulatedColumns
 }
 
 func (odbi *ovndb) rowToDHCPOptions(uuid string) *DHCPOptions {
@@ -36,11 +37,12 @@ func (odbi *ovndb) rowToDHCPOptions(uuid string) *DHCPOptions {
 
 	dhcp := &DHCPOptions{
 		UUID:       uuid,
-		CIDR:       cacheDHCPOptions.Fields["cidr"].(string),
-		Options:    cacheDHCPOptions.Fields["options"].(libovsdb.OvsMap).GoMap,
-		ExternalID: cacheDHCPOptions.Fields["external_ids"].(libovsdb.OvsMap).GoMap,
+		CIDR:       rowString(cacheDHCPOptions, "cidr"),
+		Options:    rowMap(cacheDHCPOptions, "options"),
+		ExternalID: rowMap(cacheDHCPOptions, "external_ids"),
 	}
 
+	dhcp.PopulatedColumns = odbi.populated[TableDHCPOptions]
 	return dhcp
 }
 
@@ -0,0 +1,242 @@
+/**
+ * Copyright (c) 2020 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn
+
+import (
+	"strings"
+
+	"github.com/ebay/libovsdb"
+)
+
+// DNS ovnnb item
+type DNS struct {
+	UUID       string
+	Records    map[interface{}]interface{}
+	ExternalID map[interface{}]interface{}
+	PopulatedColumns
+}
+
+func (odbi *ovndb) dnsExists(uuid string) bool {
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+
+	_, ok := odbi.cache[TableDNS][uuid]
+	return ok
+}
+
+// Add a DNS row, records maps hostnames to space-separated IP addresses.
+// The UUID of the row is returned by ExecuteR.
+func (odbi *ovndb) dnsAddImp(records map[string]string, external_ids map[string]string) (*OvnCommand, error) {
+	namedUUID, err := newRowUUID()
+	if err != nil {
+		return nil, err
+	}
+	row := make(OVNRow)
+	if records != nil {
+		oMap, err := libovsdb.NewOvsMap(records)
+		if err != nil {
+			return nil, err
+		}
+		row["records"] = oMap
+	}
+	if external_ids != nil {
+		oMap, err := libovsdb.NewOvsMap(external_ids)
+		if err != nil {
+			return nil, err
+		}
+		row["external_ids"] = oMap
+	}
+	insertOp := libovsdb.Operation{
+		Op:       opInsert,
+		Table:    TableDNS,
+		Row:      row,
+		UUIDName: namedUUID,
+	}
+	operations := []libovsdb.Operation{insertOp}
+	return &OvnCommand{operations, odbi, make([][]map[string]interface{}, len(operations))}, nil
+}
+
+// Delete the DNS row and remove it from the logical switches referring to it
+func (odbi *ovndb) dnsDelImp(uuid string) (*OvnCommand, error) {
+	if !odbi.dnsExists(uuid) {
+		return nil, ErrorNotFound
+	}
+
+	mutateSet, err := libovsdb.NewOvsSet([]libovsdb.UUID{stringToGoUUID(uuid)})
+	if err != nil {
+		return nil, err
+	}
+	mutation := libovsdb.NewMutation("dns_records", opDelete, mutateSet)
+	mutateOp := libovsdb.Operation{
+		Op:        opMutate,
+		Table:     TableLogicalSwitch,
+		Mutations: []interface{}{mutation},
+		Where:     []interface{}{libovsdb.NewCondition("dns_records", "includes", mutateSet)},
+	}
+	deleteOp := libovsdb.Operation{
+		Op:    opDelete,
+		Table: TableDNS,
+		Where: []interface{}{libovsdb.NewCondition("_uuid", "==", stringToGoUUID(uuid))},
+	}
+	operations := []libovsdb.Operation{mutateOp, deleteOp}
+	return &OvnCommand{operations, odbi, make([][]map[string]interface{}, len(operations))}, nil
+}
+
+func (odbi *ovndb) dnsGetImp(uuid string) (*DNS, error) {
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+
+	if _, ok := odbi.cache[TableDNS]; !ok {
+		return nil, ErrorSchema
+	}
+	dns := odbi.rowToDNS(uuid)
+	if dns == nil {
+		return nil, ErrorNotFound
+	}
+	return dns, nil
+}
+
+func (odbi *ovndb) dnsListImp() ([]*DNS, error) {
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+
+	cacheDNS, ok := odbi.cache[TableDNS]
+	if !ok {
+		return nil, ErrorSchema
+	}
+
+	listDNS := make([]*DNS, 0, len(cacheDNS))
+	for uuid := range cacheDNS {
+		listDNS = append(listDNS, odbi.rowToDNS(uuid))
+	}
+	return listDNS, nil
+}
+
+// Set the IP addresses of hostname in the DNS row, replacing existing ones
+func (odbi *ovndb) dnsSetRecordImp(uuid, hostname string, ips ...string) (*OvnCommand, error) {
+	if !odbi.dnsExists(uuid) {
+		return nil, ErrorNotFound
+	}
+
+	keys, err := libovsdb.NewOvsSet([]string{hostname})
+	if err != nil {
+		return nil, err
+	}
+	record, err := libovsdb.NewOvsMap(map[string]string{hostname: strings.Join(ips, " ")})
+	if err != nil {
+		return nil, err
+	}
+	// insert does not replace the value of an existing key
+	mutateOp := libovsdb.Operation{
+		Op:    opMutate,
+		Table: TableDNS,
+		Mutations: []interface{}{
+			libovsdb.NewMutation("records", opDelete, keys),
+			libovsdb.NewMutation("records", opInsert, record),
+		},
+		Where: []interface{}{libovsdb.NewCondition("_uuid", "==", stringToGoUUID(uuid))},
+	}
+	operations := []libovsdb.Operation{mutateOp}
+	return &OvnCommand{operations, odbi, make([][]map[string]interface{}, len(operations))}, nil
+}
+
+// Remove hostname from the DNS row
+func (odbi *ovndb) dnsRemoveRecordImp(uuid, hostname string) (*OvnCommand, error) {
+	if !odbi.dnsExists(uuid) {
+		return nil, ErrorNotFound
+	}
+
+	keys, err := libovsdb.NewOvsSet([]string{hostname})
+	if err != nil {
+		return nil, err
+	}
+	mutateOp := libovsdb.Operation{
+		Op:        opMutate,
+		Table:     TableDNS,
+		Mutations: []interface{}{libovsdb.NewMutation("records", opDelete, keys)},
+		Where:     []interface{}{libovsdb.NewCondition("_uuid", "==", stringToGoUUID(uuid))},
+	}
+	operations := []libovsdb.Operation{mutateOp}
+	return &OvnCommand{operations, odbi, make([][]map[string]interface{}, len(operations))}, nil
+}
+
+func (odbi *ovndb) lsDNSMutateImp(lswitch, uuid, mutator string) (*OvnCommand, error) {
+	if !odbi.dnsExists(uuid) {
+		return nil, ErrorNotFound
+	}
+	row := make(OVNRow)
+	row["name"] = lswitch
+	if lsuuid := odbi.getRowUUID(TableLogicalSwitch, row); len(lsuuid) == 0 {
+		return nil, ErrorNotFound
+	}
+
+	mutateSet, err := libovsdb.NewOvsSet([]libovsdb.UUID{stringToGoUUID(uuid)})
+	if err != nil {
+		return nil, err
+	}
+	mutation := libovsdb.NewMutation("dns_records", mutator, mutateSet)
+	condition := libovsdb.NewCondition("name", "==", lswitch)
+	mutateOp := libovsdb.Operation{
+		Op:        opMutate,
+		Table:     TableLogicalSwitch,
+		Mutations: []interface{}{mutation},
+		Where:     []interface{}{condition},
+	}
+	operations := []libovsdb.Operation{mutateOp}
+	return &OvnCommand{operations, odbi, make([][]map[string]interface{}, len(operations))}, nil
+}
+
+func (odbi *ovndb) lsDNSAddImp(lswitch, uuid string) (*OvnCommand, error) {
+	return odbi.lsDNSMutateImp(lswitch, uuid, opInsert)
+}
+
+func (odbi *ovndb) lsDNSDelImp(lswitch, uuid string) (*OvnCommand, error) {
+	return odbi.lsDNSMutateImp(lswitch, uuid, opDelete)
+}
+
+func (odbi *ovndb) lsDNSListImp(lswitch string) ([]*DNS, error) {
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+
+	for _, drows := range odbi.cache[TableLogicalSwitch] {
+		if name, ok := drows.Fields["name"].(string); ok && name == lswitch {
+			var listDNS []*DNS
+			for _, uuid := range rowSet(drows, "dns_records") {
+				if dns := odbi.rowToDNS(uuid); dns != nil {
+					listDNS = append(listDNS, dns)
+				}
+			}
+			return listDNS, nil
+		}
+	}
+	return nil, ErrorNotFound
+}
+
+func (odbi *ovndb) rowToDNS(uuid string) *DNS {
+	cacheDNS, ok := odbi.cache[TableDNS][uuid]
+	if !ok {
+		return nil
+	}
+
+	dns := &DNS{
+		UUID:       uuid,
+		Records:    rowMap(cacheDNS, "records"),
+		ExternalID: rowMap(cacheDNS, "external_ids"),
+	}
+	dns.PopulatedColumns = odbi.populated[TableDNS]
+	return dns
+}
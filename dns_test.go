@@ -0,0 +1,122 @@
+/**
+ * Copyright (c) 2020 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const DNS_HOST = "vm1.ovn.org"
+
+func TestDNS(t *testing.T) {
+	ovndbapi := getOVNClient(DBNB)
+	defer ovndbapi.Close()
+
+	cmd, err := ovndbapi.LSAdd(LSW)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("Adding DNS record %s", DNS_HOST)
+	cmd, err = ovndbapi.DNSAdd(map[string]string{DNS_HOST: "10.0.0.10"}, map[string]string{FOO: BAR})
+	if err != nil {
+		t.Fatal(err)
+	}
+	uuids, err := ovndbapi.ExecuteR(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 1, len(uuids))
+	dnsUUID := uuids[0]
+
+	cmd, err = ovndbapi.DNSSetRecord(dnsUUID, DNS_HOST, "10.0.0.11", "fd00::11")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dns, err := ovndbapi.DNSGet(dnsUUID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "10.0.0.11 fd00::11", dns.Records[DNS_HOST])
+	assert.Equal(t, BAR, dns.ExternalID[FOO])
+
+	t.Logf("Adding DNS record to logical switch %s", LSW)
+	cmd, err = ovndbapi.LSDNSAdd(LSW, dnsUUID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lsDNS, err := ovndbapi.LSDNSList(LSW)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 1, len(lsDNS))
+	assert.Equal(t, dnsUUID, lsDNS[0].UUID)
+
+	cmd, err = ovndbapi.DNSRemoveRecord(dnsUUID, DNS_HOST)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dns, err = ovndbapi.DNSGet(dnsUUID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 0, len(dns.Records))
+
+	t.Logf("Deleting DNS record %s", dnsUUID)
+	cmd, err = ovndbapi.DNSDel(dnsUUID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lsDNS, err = ovndbapi.LSDNSList(LSW)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 0, len(lsDNS))
+	_, err = ovndbapi.DNSGet(dnsUUID)
+	assert.Equal(t, ErrorNotFound, err)
+
+	cmd, err = ovndbapi.LSDel(LSW)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -28,6 +28,7 @@ type Encap struct {
 	Ip          string
 	Options     map[interface{}]interface{}
 	Encaptype   string
+	PopulatedColumns
 }
 
 func (odbi *ovndb) encapListImp(chassisName string) ([]*Encap, error) {
@@ -84,10 +85,11 @@ func (odbi *ovndb) rowToEncap(uuid string) (*Encap, error) {
 	}
 	en := &Encap{
 		UUID:        uuid,
-		ChassisName: cacheEncaps.Fields["chassis_name"].(string),
-		Ip:          cacheEncaps.Fields["ip"].(string),
-		Options:     cacheEncaps.Fields["options"].(libovsdb.OvsMap).GoMap,
-		Encaptype:   cacheEncaps.Fields["type"].(string),
+		ChassisName: rowString(cacheEncaps, "chassis_name"),
+		Ip:          rowString(cacheEncaps, "ip"),
+		Options:     rowMap(cacheEncaps, "options"),
+		Encaptype:   rowString(cacheEncaps, "type"),
 	}
+	en.PopulatedColumns = odbi.populated[TableEncap]
 	return en, nil
 }
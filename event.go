@@ -0,0 +1,132 @@
+/**
+ * Copyright (c) 2020 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/ebay/libovsdb"
+)
+
+// EventType is the kind of change reported by an Event
+type EventType string
+
+const (
+	EventAdd    EventType = "add"
+	EventUpdate EventType = "update"
+	EventDelete EventType = "delete"
+)
+
+// Event describes a change of a row in a monitored table.
+// Old and New hold the typed object of the row before and after the change,
+// e.g. *LogicalSwitch for TableLogicalSwitch, and are nil for tables without
+// a typed API. OldRow and NewRow always hold the raw rows. Old and OldRow are
+// not set for EventAdd, New and NewRow are not set for EventDelete.
+type Event struct {
+	Type   EventType
+	Table  string
+	UUID   string
+	Old    interface{}
+	New    interface{}
+	OldRow *libovsdb.Row
+	NewRow *libovsdb.Row
+}
+
+// EventFilter selects the events passed to an EventHandler
+type EventFilter func(event *Event) bool
+
+// EventHandler is called for the events of a table that pass its filter
+type EventHandler func(event *Event)
+
+// EventHandlerID identifies a registered EventHandler
+type EventHandlerID uint64
+
+type eventHandler struct {
+	table   string
+	filter  EventFilter
+	handler EventHandler
+}
+
+// eventHandlers is the set of event handlers registered on a client
+type eventHandlers struct {
+	mutex    sync.RWMutex
+	lastID   EventHandlerID
+	handlers map[EventHandlerID]*eventHandler
+}
+
+func (eh *eventHandlers) add(table string, filter EventFilter, handler EventHandler) EventHandlerID {
+	eh.mutex.Lock()
+	defer eh.mutex.Unlock()
+
+	if eh.handlers == nil {
+		eh.handlers = make(map[EventHandlerID]*eventHandler)
+	}
+	eh.lastID++
+	eh.handlers[eh.lastID] = &eventHandler{table, filter, handler}
+	return eh.lastID
+}
+
+func (eh *eventHandlers) remove(id EventHandlerID) error {
+	eh.mutex.Lock()
+	defer eh.mutex.Unlock()
+
+	if _, ok := eh.handlers[id]; !ok {
+		return ErrorNotFound
+	}
+	delete(eh.handlers, id)
+	return nil
+}
+
+// forTable returns the handlers registered for table. The handlers are
+// returned by copy so that they can add or remove handlers when called.
+func (eh *eventHandlers) forTable(table string) []*eventHandler {
+	eh.mutex.RLock()
+	defer eh.mutex.RUnlock()
+
+	var handlers []*eventHandler
+	for _, h := range eh.handlers {
+		if h.table == "" || h.table == table {
+			handlers = append(handlers, h)
+		}
+	}
+	return handlers
+}
+
+func (odbi *ovndb) addEventHandlerImp(table string, filter EventFilter, handler EventHandler) (EventHandlerID, error) {
+	if handler == nil {
+		return 0, fmt.Errorf("event handler must not be nil")
+	}
+	if table != "" {
+		if _, ok := odbi.tableCols[table]; !ok {
+			return 0, fmt.Errorf("table %q in database %q is not monitored", table, odbi.db)
+		}
+	}
+	return odbi.eventHandlers.add(table, filter, handler), nil
+}
+
+func (odbi *ovndb) removeEventHandlerImp(id EventHandlerID) error {
+	return odbi.eventHandlers.remove(id)
+}
+
+func dispatchEvent(handlers []*eventHandler, event *Event) {
+	for _, h := range handlers {
+		if h.filter == nil || h.filter(event) {
+			h.handler(event)
+		}
+	}
+}
@@ -0,0 +1,176 @@
+/**
+ * Copyright (c) 2020 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn
+
+import (
+	"errors"
+	"sync"
+)
+
+// DefaultEventQueueDepth is the event queue depth used if
+// Config.EventQueueDepth is not set
+const DefaultEventQueueDepth = 4096
+
+// EventOverflowPolicy decides what happens to events when the event queue
+// of a client is full
+type EventOverflowPolicy int
+
+const (
+	// Drop the oldest queued event, the default
+	EventOverflowDropOldest EventOverflowPolicy = iota
+	// Drop the new event
+	EventOverflowDropNewest
+	// Wait until the callbacks consumed queued events. The wait happens on
+	// the goroutine reading from the db server, so no reply or notification
+	// is received meanwhile: a callback that waits for the result of Execute
+	// or of another request deadlocks the client.
+	EventOverflowBlock
+)
+
+var errEventQueueFull = errors.New("event queue full")
+
+type queuedEvent struct {
+	event    *Event
+	handlers []*eventHandler
+}
+
+// eventQueue delivers the events of cache updates to OVNSignal and the event
+// handlers in the order the updates were applied to the cache. Events are
+// staged while the cache is locked and moved to the bounded queue by flush
+// once it is unlocked, so callbacks run outside of the cache lock.
+type eventQueue struct {
+	mutex      sync.Mutex
+	flushMutex sync.Mutex
+	notEmpty   *sync.Cond
+	notFull    *sync.Cond
+	staged     []*queuedEvent
+	events     []*queuedEvent
+	depth      int
+	overflow   EventOverflowPolicy
+	dropped    uint64
+	closed     bool
+	logger     Logger
+}
+
+func newEventQueue(depth int, overflow EventOverflowPolicy, logger Logger) *eventQueue {
+	if depth <= 0 {
+		depth = DefaultEventQueueDepth
+	}
+	if logger == nil {
+		logger = stdLogger{}
+	}
+	q := &eventQueue{
+		depth:    depth,
+		overflow: overflow,
+		logger:   logger,
+	}
+	q.notEmpty = sync.NewCond(&q.mutex)
+	q.notFull = sync.NewCond(&q.mutex)
+	return q
+}
+
+// stage appends events in cache update order, it never blocks
+func (q *eventQueue) stage(events []*queuedEvent) {
+	if len(events) == 0 {
+		return
+	}
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	if q.closed {
+		return
+	}
+	q.staged = append(q.staged, events...)
+}
+
+// flush moves staged events to the queue, applying the overflow policy
+func (q *eventQueue) flush() {
+	q.flushMutex.Lock()
+	defer q.flushMutex.Unlock()
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	var dropped uint64
+	for len(q.staged) > 0 && !q.closed {
+		qe := q.staged[0]
+		q.staged[0] = nil
+		q.staged = q.staged[1:]
+
+		for len(q.events) >= q.depth && qe != nil && !q.closed {
+			switch q.overflow {
+			case EventOverflowDropOldest:
+				q.events[0] = nil
+				q.events = q.events[1:]
+				dropped++
+			case EventOverflowDropNewest:
+				qe = nil
+				dropped++
+			default:
+				q.notFull.Wait()
+			}
+		}
+		if qe != nil && !q.closed {
+			q.events = append(q.events, qe)
+			q.notEmpty.Signal()
+		}
+	}
+	if dropped > 0 {
+		q.dropped += dropped
+		q.logger.Error(errEventQueueFull, "events dropped", "dropped", dropped, "total", q.dropped)
+	}
+}
+
+// pop waits for the next event, it returns false once the queue is closed
+func (q *eventQueue) pop() (*queuedEvent, bool) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	for len(q.events) == 0 && !q.closed {
+		q.notEmpty.Wait()
+	}
+	if q.closed {
+		return nil, false
+	}
+	qe := q.events[0]
+	q.events[0] = nil
+	q.events = q.events[1:]
+	q.notFull.Signal()
+	return qe, true
+}
+
+// close discards queued events and stops the dispatcher
+func (q *eventQueue) close() {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	q.closed = true
+	q.staged = nil
+	q.events = nil
+	q.notEmpty.Broadcast()
+	q.notFull.Broadcast()
+}
+
+// dispatch delivers queued events until the queue is closed
+func (odbi *ovndb) dispatch() {
+	for {
+		qe, ok := odbi.events.pop()
+		if !ok {
+			return
+		}
+		odbi.deliver(qe)
+	}
+}
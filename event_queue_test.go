@@ -0,0 +1,138 @@
+/**
+ * Copyright (c) 2020 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func queuedEvents(uuids ...string) []*queuedEvent {
+	var events []*queuedEvent
+	for _, uuid := range uuids {
+		events = append(events, &queuedEvent{event: &Event{UUID: uuid}})
+	}
+	return events
+}
+
+func popUUIDs(q *eventQueue, n int) []string {
+	var uuids []string
+	for i := 0; i < n; i++ {
+		qe, ok := q.pop()
+		if !ok {
+			break
+		}
+		uuids = append(uuids, qe.event.UUID)
+	}
+	return uuids
+}
+
+func TestEventQueueOverflow(t *testing.T) {
+	q := newEventQueue(2, EventOverflowDropOldest, nil)
+	q.stage(queuedEvents("a", "b"))
+	q.stage(queuedEvents("c"))
+	q.flush()
+	assert.Equal(t, []string{"b", "c"}, popUUIDs(q, 2))
+
+	q = newEventQueue(2, EventOverflowDropNewest, nil)
+	q.stage(queuedEvents("a", "b", "c"))
+	q.flush()
+	assert.Equal(t, []string{"a", "b"}, popUUIDs(q, 2))
+	assert.Equal(t, uint64(1), q.dropped)
+
+	q = newEventQueue(2, EventOverflowBlock, nil)
+	q.stage(queuedEvents("a", "b", "c", "d"))
+	flushed := make(chan struct{})
+	go func() {
+		q.flush()
+		close(flushed)
+	}()
+	assert.Equal(t, []string{"a", "b", "c", "d"}, popUUIDs(q, 4))
+	select {
+	case <-flushed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("flush blocked after events were consumed")
+	}
+
+	q.close()
+	_, ok := q.pop()
+	assert.False(t, ok)
+}
+
+func TestEventQueueDefaultOverflow(t *testing.T) {
+	var cfg Config
+	q := newEventQueue(1, cfg.EventQueueOverflow, nil)
+	q.stage(queuedEvents("a", "b", "c"))
+	flushed := make(chan struct{})
+	go func() {
+		q.flush()
+		close(flushed)
+	}()
+	select {
+	case <-flushed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("flush blocked with the default overflow policy")
+	}
+	assert.Equal(t, []string{"c"}, popUUIDs(q, 1))
+}
+
+func TestEventHandlerReadsCache(t *testing.T) {
+	ovndbapi := getOVNClient(DBNB)
+	defer ovndbapi.Close()
+
+	// handlers run outside of the cache lock, so they can read the cache
+	found := make(chan []*LogicalSwitch, 1)
+	id, err := ovndbapi.AddEventHandler(TableLogicalSwitch, func(event *Event) bool {
+		return event.Type == EventAdd && event.New.(*LogicalSwitch).Name == LSW
+	}, func(event *Event) {
+		ls, err := ovndbapi.LSGet(LSW)
+		if err != nil {
+			t.Error(err)
+		}
+		found <- ls
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ovndbapi.RemoveEventHandler(id)
+
+	cmd, err := ovndbapi.LSAdd(LSW)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case ls := <-found:
+		assert.Equal(t, 1, len(ls))
+	case <-time.After(5 * time.Second):
+		t.Fatal("event handler did not complete")
+	}
+
+	cmd, err = ovndbapi.LSDel(LSW)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
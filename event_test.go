@@ -0,0 +1,129 @@
+/**
+ * Copyright (c) 2020 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	EVENT_AS = "EVENT_AS"
+)
+
+func waitEvent(t *testing.T, events chan *Event) *Event {
+	select {
+	case event := <-events:
+		return event
+	case <-time.After(5 * time.Second):
+		t.Fatal("no event received")
+	}
+	return nil
+}
+
+func TestEventHandler(t *testing.T) {
+	ovndbapi := getOVNClient(DBNB)
+	defer ovndbapi.Close()
+
+	_, err := ovndbapi.AddEventHandler("Unknown_Table", nil, func(*Event) {})
+	assert.NotNil(t, err)
+
+	byName := func(event *Event) bool {
+		if event.Type == EventDelete {
+			return event.Old.(*AddressSet).Name == EVENT_AS
+		}
+		return event.New.(*AddressSet).Name == EVENT_AS
+	}
+	events := make(chan *Event, 10)
+	id, err := ovndbapi.AddEventHandler(TableAddressSet, byName, func(event *Event) {
+		events <- event
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// a second subscriber on all tables sees the same changes
+	allEvents := make(chan *Event, 10)
+	allID, err := ovndbapi.AddEventHandler("", func(event *Event) bool {
+		return event.Table == TableAddressSet
+	}, func(event *Event) {
+		allEvents <- event
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cmd, err := ovndbapi.ASAdd(EVENT_AS, []string{"10.0.0.1"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	event := waitEvent(t, events)
+	assert.Equal(t, EventAdd, event.Type)
+	assert.Equal(t, TableAddressSet, event.Table)
+	assert.Nil(t, event.Old)
+	assert.Equal(t, []string{"10.0.0.1"}, event.New.(*AddressSet).Addresses)
+	assert.Equal(t, EVENT_AS, event.NewRow.Fields["name"])
+	event = waitEvent(t, allEvents)
+	assert.Equal(t, EventAdd, event.Type)
+
+	cmd, err = ovndbapi.ASUpdate(EVENT_AS, []string{"10.0.0.2"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	event = waitEvent(t, events)
+	assert.Equal(t, EventUpdate, event.Type)
+	assert.Equal(t, []string{"10.0.0.1"}, event.Old.(*AddressSet).Addresses)
+	assert.Equal(t, []string{"10.0.0.2"}, event.New.(*AddressSet).Addresses)
+	assert.Equal(t, event.UUID, event.New.(*AddressSet).UUID)
+	event = waitEvent(t, allEvents)
+	assert.Equal(t, EventUpdate, event.Type)
+
+	err = ovndbapi.RemoveEventHandler(allID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, ErrorNotFound, ovndbapi.RemoveEventHandler(allID))
+
+	cmd, err = ovndbapi.ASDel(EVENT_AS)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	event = waitEvent(t, events)
+	assert.Equal(t, EventDelete, event.Type)
+	assert.Nil(t, event.New)
+	assert.Nil(t, event.NewRow)
+	assert.Equal(t, EVENT_AS, event.Old.(*AddressSet).Name)
+	assert.Equal(t, 0, len(allEvents))
+
+	err = ovndbapi.RemoveEventHandler(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
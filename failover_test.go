@@ -0,0 +1,100 @@
+/**
+ * Copyright (c) 2020 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn_test
+
+import (
+	"testing"
+	"time"
+
+	goovn "github.com/ebay/go-ovn"
+	"github.com/ebay/go-ovn/goovntest"
+	"github.com/stretchr/testify/assert"
+)
+
+// startServer starts a server of the OVN schemas that is closed when the
+// test completes
+func startServer(t *testing.T) (*goovntest.Server, string) {
+	srv, err := goovntest.NewOVNServer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { srv.Close() })
+	addr, err := srv.Listen()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return srv, addr
+}
+
+func TestClusterFailover(t *testing.T) {
+	srv1, addr1 := startServer(t)
+	srv2, addr2 := startServer(t)
+	if err := srv1.SetLeader(goovn.DBNB, true); err != nil {
+		t.Fatal(err)
+	}
+	if err := srv2.SetLeader(goovn.DBNB, false); err != nil {
+		t.Fatal(err)
+	}
+
+	// tell the servers apart by a switch of the second one
+	c2, err := goovn.NewClient(&goovn.Config{Db: goovn.DBNB, Addr: addr2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c2.Close()
+	cmd, err := c2.LSAdd("ls2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := c2.Execute(cmd); err != nil {
+		t.Fatal(err)
+	}
+
+	reconnected := make(chan struct{}, 1)
+	// the unreachable remote and the follower are skipped wherever they
+	// land after shuffling
+	c, err := goovn.NewClient(&goovn.Config{
+		Db:              goovn.DBNB,
+		Addr:            "unix:/nonexistent/ovnnb_db.sock," + addr1 + "," + addr2,
+		LeaderOnly:      true,
+		ReconnectPolicy: goovn.ReconnectPolicy{InitialBackoff: 10 * time.Millisecond},
+		OnReconnected: func(int) {
+			reconnected <- struct{}{}
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	_, err = c.LSGet("ls2")
+	assert.Equal(t, goovn.ErrorNotFound, err)
+
+	// the client follows the leadership to the second server
+	if err := srv2.SetLeader(goovn.DBNB, true); err != nil {
+		t.Fatal(err)
+	}
+	if err := srv1.SetLeader(goovn.DBNB, false); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-reconnected:
+	case <-time.After(5 * time.Second):
+		t.Fatal("not reconnected after the leader changed")
+	}
+	_, err = c.LSGet("ls2")
+	assert.NoError(t, err)
+}
@@ -16,6 +16,12 @@
 
 package goovn
 
+import (
+	"sort"
+
+	"github.com/ebay/libovsdb"
+)
+
 // GatewayChassis ovnnb item
 type GatewayChassis struct {
 	UUID        string
@@ -24,4 +30,144 @@ type GatewayChassis struct {
 	Priority    int
 	Options     map[interface{}]interface{}
 	ExternalID  map[interface{}]interface{}
+	PopulatedColumns
+}
+
+// lrpGatewayChassisUUID returns the UUID of the gateway chassis of lrp on
+// chassis, or "" if there is none. The cache must be locked by the caller.
+func (odbi *ovndb) lrpGatewayChassisUUID(lrpUUID, chassis string) string {
+	for _, uuid := range rowSet(odbi.cache[TableLogicalRouterPort][lrpUUID], "gateway_chassis") {
+		if rowString(odbi.cache[TableGatewayChassis][uuid], "chassis_name") == chassis {
+			return uuid
+		}
+	}
+	return ""
+}
+
+func (odbi *ovndb) lrpSetGatewayChassisImp(lrp, chassis string, priority int) (*OvnCommand, error) {
+	lrpUUID := odbi.getRowUUID(TableLogicalRouterPort, OVNRow{"name": lrp})
+	if len(lrpUUID) == 0 {
+		return nil, ErrorNotFound
+	}
+
+	odbi.cachemutex.RLock()
+	gcUUID := odbi.lrpGatewayChassisUUID(lrpUUID, chassis)
+	odbi.cachemutex.RUnlock()
+
+	// like ovn-nbctl lrp-set-gateway-chassis, an existing gateway chassis
+	// only gets its priority updated
+	if len(gcUUID) > 0 {
+		updateOp := libovsdb.Operation{
+			Op:    opUpdate,
+			Table: TableGatewayChassis,
+			Row:   OVNRow{"priority": priority},
+			Where: []interface{}{libovsdb.NewCondition("_uuid", "==", stringToGoUUID(gcUUID))},
+		}
+		operations := []libovsdb.Operation{updateOp}
+		return &OvnCommand{operations, odbi, make([][]map[string]interface{}, len(operations))}, nil
+	}
+
+	namedUUID, err := newRowUUID()
+	if err != nil {
+		return nil, err
+	}
+	row := make(OVNRow)
+	row["name"] = lrp + "-" + chassis
+	row["chassis_name"] = chassis
+	row["priority"] = priority
+	insertOp := libovsdb.Operation{
+		Op:       opInsert,
+		Table:    TableGatewayChassis,
+		Row:      row,
+		UUIDName: namedUUID,
+	}
+
+	mutateSet, err := libovsdb.NewOvsSet([]libovsdb.UUID{stringToGoUUID(namedUUID)})
+	if err != nil {
+		return nil, err
+	}
+	mutation := libovsdb.NewMutation("gateway_chassis", opInsert, mutateSet)
+	condition := libovsdb.NewCondition("_uuid", "==", stringToGoUUID(lrpUUID))
+	mutateOp := libovsdb.Operation{
+		Op:        opMutate,
+		Table:     TableLogicalRouterPort,
+		Mutations: []interface{}{mutation},
+		Where:     []interface{}{condition},
+	}
+	operations := []libovsdb.Operation{insertOp, mutateOp}
+	return &OvnCommand{operations, odbi, make([][]map[string]interface{}, len(operations))}, nil
+}
+
+func (odbi *ovndb) lrpDelGatewayChassisImp(lrp, chassis string) (*OvnCommand, error) {
+	lrpUUID := odbi.getRowUUID(TableLogicalRouterPort, OVNRow{"name": lrp})
+	if len(lrpUUID) == 0 {
+		return nil, ErrorNotFound
+	}
+
+	odbi.cachemutex.RLock()
+	gcUUID := odbi.lrpGatewayChassisUUID(lrpUUID, chassis)
+	odbi.cachemutex.RUnlock()
+	if len(gcUUID) == 0 {
+		return nil, ErrorNotFound
+	}
+
+	// the unreferenced Gateway_Chassis row is garbage collected
+	mutateSet, err := libovsdb.NewOvsSet([]libovsdb.UUID{stringToGoUUID(gcUUID)})
+	if err != nil {
+		return nil, err
+	}
+	mutation := libovsdb.NewMutation("gateway_chassis", opDelete, mutateSet)
+	condition := libovsdb.NewCondition("_uuid", "==", stringToGoUUID(lrpUUID))
+	mutateOp := libovsdb.Operation{
+		Op:        opMutate,
+		Table:     TableLogicalRouterPort,
+		Mutations: []interface{}{mutation},
+		Where:     []interface{}{condition},
+	}
+	operations := []libovsdb.Operation{mutateOp}
+	return &OvnCommand{operations, odbi, make([][]map[string]interface{}, len(operations))}, nil
+}
+
+// List the gateway chassis of lrp ordered by descending priority
+func (odbi *ovndb) lrpGatewayChassisListImp(lrp string) ([]*GatewayChassis, error) {
+	lrpUUID := odbi.getRowUUID(TableLogicalRouterPort, OVNRow{"name": lrp})
+	if len(lrpUUID) == 0 {
+		return nil, ErrorNotFound
+	}
+
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+
+	if _, ok := odbi.cache[TableGatewayChassis]; !ok {
+		return nil, ErrorSchema
+	}
+
+	var listGC []*GatewayChassis
+	for _, uuid := range rowSet(odbi.cache[TableLogicalRouterPort][lrpUUID], "gateway_chassis") {
+		if gc := odbi.rowToGatewayChassis(uuid); gc != nil {
+			listGC = append(listGC, gc)
+		}
+	}
+	sort.SliceStable(listGC, func(i, j int) bool {
+		return listGC[i].Priority > listGC[j].Priority
+	})
+	return listGC, nil
+}
+
+func (odbi *ovndb) rowToGatewayChassis(uuid string) *GatewayChassis {
+	cacheGatewayChassis, ok := odbi.cache[TableGatewayChassis][uuid]
+	if !ok {
+		return nil
+	}
+
+	gc := &GatewayChassis{
+		UUID:        uuid,
+		Name:        rowString(cacheGatewayChassis, "name"),
+		ChassisName: rowString(cacheGatewayChassis, "chassis_name"),
+		Priority:    rowInt(cacheGatewayChassis, "priority"),
+		Options:     rowMap(cacheGatewayChassis, "options"),
+		ExternalID:  rowMap(cacheGatewayChassis, "external_ids"),
+	}
+	gc.PopulatedColumns = odbi.populated[TableGatewayChassis]
+	return gc
 }
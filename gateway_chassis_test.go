@@ -0,0 +1,112 @@
+/**
+ * Copyright (c) 2020 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	GW_CHASSIS1 = "gw-chassis-1"
+	GW_CHASSIS2 = "gw-chassis-2"
+)
+
+func TestLRPGatewayChassis(t *testing.T) {
+	ovndbapi := getOVNClient(DBNB)
+	defer ovndbapi.Close()
+
+	cmd, err := ovndbapi.LRAdd(LR, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmd, err = ovndbapi.LRPAdd(LR, LRP, "54:54:54:54:54:54", []string{"192.168.0.1/24"}, "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("Setting gateway chassis of %s", LRP)
+	for chassis, priority := range map[string]int{GW_CHASSIS1: 10, GW_CHASSIS2: 20} {
+		cmd, err = ovndbapi.LRPSetGatewayChassis(LRP, chassis, priority)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = ovndbapi.Execute(cmd)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	gcs, err := ovndbapi.LRPGatewayChassisList(LRP)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 2, len(gcs))
+	assert.Equal(t, GW_CHASSIS2, gcs[0].ChassisName)
+	assert.Equal(t, LRP+"-"+GW_CHASSIS2, gcs[0].Name)
+
+	// setting an existing gateway chassis updates its priority
+	cmd, err = ovndbapi.LRPSetGatewayChassis(LRP, GW_CHASSIS1, 30)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gcs, err = ovndbapi.LRPGatewayChassisList(LRP)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 2, len(gcs))
+	assert.Equal(t, GW_CHASSIS1, gcs[0].ChassisName)
+	assert.Equal(t, 30, gcs[0].Priority)
+
+	t.Logf("Deleting gateway chassis %s of %s", GW_CHASSIS2, LRP)
+	cmd, err = ovndbapi.LRPDelGatewayChassis(LRP, GW_CHASSIS2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gcs, err = ovndbapi.LRPGatewayChassisList(LRP)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 1, len(gcs))
+	_, err = ovndbapi.LRPDelGatewayChassis(LRP, GW_CHASSIS2)
+	assert.Equal(t, ErrorNotFound, err)
+
+	cmd, err = ovndbapi.LRDel(LR)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
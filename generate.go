@@ -0,0 +1,26 @@
+/**
+ * Copyright (c) 2020 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn
+
+// The table constants of goovn and the models of every table of the OVN
+// schemas are generated from the schema files pinned in model/nb and
+// model/sb, those of an OVN release. Run make schemas OVN_VERSION=<tag> to
+// fetch the schema files of another release and regenerate. See Config.Models.
+//
+//go:generate go run ./cmd/modelgen -tables -schema model/nb/ovn-nb.ovsschema,model/sb/ovn-sb.ovsschema -package goovn -out tables.go
+//go:generate go run ./cmd/modelgen -schema model/nb/ovn-nb.ovsschema -package nb -out model/nb/model.go
+//go:generate go run ./cmd/modelgen -schema model/sb/ovn-sb.ovsschema -package sb -out model/sb/model.go
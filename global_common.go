@@ -22,6 +22,28 @@ import (
 	"github.com/ebay/libovsdb"
 )
 
+// globalTable returns the NB_Global or SB_Global table of the client db
+func (odbi *ovndb) globalTable() string {
+	if odbi.db == DBSB {
+		return TableSBGlobal
+	}
+	return TableNBGlobal
+}
+
+// globalTableRowUUID returns the UUID of the single row of the global table
+func (odbi *ovndb) globalTableRowUUID(table string) (string, error) {
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+	cacheGlobal, ok := odbi.cache[table]
+	if !ok {
+		return "", fmt.Errorf("Table %s not found in cache %v", table, odbi.cache)
+	}
+	for uuid := range cacheGlobal {
+		return uuid, nil
+	}
+	return "", fmt.Errorf("No row found in %s table", table)
+}
+
 func (odbi *ovndb) addGlobalTableRowImp(options map[string]string, table string) (*OvnCommand, error) {
 	namedUUID, err := newRowUUID()
 	if err != nil {
@@ -57,18 +79,7 @@ func (odbi *ovndb) delGlobalTableRowImp(table string) (*OvnCommand, error) {
 		return nil, fmt.Errorf("Invalid table name passed to delete")
 	}
 
-	uuid, err := func() (string, error) {
-		odbi.cachemutex.RLock()
-		defer odbi.cachemutex.RUnlock()
-		cacheGlobal, ok := odbi.cache[table]
-		if !ok {
-			return "", fmt.Errorf("Table %s not found in cache %v", table, odbi.cache)
-		}
-		for uuid, _ := range cacheGlobal {
-			return uuid, nil
-		}
-		return "", fmt.Errorf("No row found in %s table", table)
-	}()
+	uuid, err := odbi.globalTableRowUUID(table)
 	if err != nil {
 		return nil, err
 	}
@@ -92,18 +103,7 @@ func (odbi *ovndb) globalSetOptionsImp(options map[string]string, table string)
 		return nil, err
 	}
 
-	uuid, err := func() (string, error) {
-		odbi.cachemutex.RLock()
-		defer odbi.cachemutex.RUnlock()
-		cacheGlobal, ok := odbi.cache[table]
-		if !ok {
-			return "", fmt.Errorf("Table %s not found in cache %v", table, odbi.cache)
-		}
-		for uuid, _ := range cacheGlobal {
-			return uuid, nil
-		}
-		return "", fmt.Errorf("No row found in %s table", table)
-	}()
+	uuid, err := odbi.globalTableRowUUID(table)
 	if err != nil {
 		return nil, err
 	}
@@ -151,3 +151,22 @@ func (odbi *ovndb) globalGetOptionsImp(table string) (map[string]string, error)
 	}
 	return nil, fmt.Errorf("No row found in %s table", table)
 }
+
+// globalUpdateOp returns the update of column of the row of the global table
+// of the client db
+func (odbi *ovndb) globalUpdateOp(column string, value interface{}) (libovsdb.Operation, error) {
+	table := odbi.globalTable()
+	uuid, err := odbi.globalTableRowUUID(table)
+	if err != nil {
+		return libovsdb.Operation{}, err
+	}
+	row := make(OVNRow)
+	row[column] = value
+	condition := libovsdb.NewCondition("_uuid", "==", stringToGoUUID(uuid))
+	return libovsdb.Operation{
+		Op:    opUpdate,
+		Table: table,
+		Row:   row,
+		Where: []interface{}{condition},
+	}, nil
+}
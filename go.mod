@@ -1,9 +1,11 @@
 module github.com/ebay/go-ovn
 
-go 1.12
+go 1.14
 
 require (
 	github.com/ebay/libovsdb v0.0.0-20190718202342-e49b8c4e1142
 	github.com/google/uuid v1.1.1
+	github.com/prometheus/client_golang v1.7.0
 	github.com/stretchr/testify v1.4.0
+	gopkg.in/yaml.v2 v2.2.5
 )
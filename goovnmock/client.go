@@ -0,0 +1,1709 @@
+// Code generated by mockgen. DO NOT EDIT.
+
+package goovnmock
+
+import (
+	"context"
+
+	goovn "github.com/ebay/go-ovn"
+	"github.com/ebay/libovsdb"
+)
+
+var _ goovn.Client = (*Client)(nil)
+
+func (m *Client) LSGet(ls string) ([]*goovn.LogicalSwitch, error) {
+	ret := m.called("LSGet", []interface{}{ls}, false, func() []interface{} {
+		r0, r1 := m.Fallback.LSGet(ls)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).([]*goovn.LogicalSwitch)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) LSAdd(ls string) (*goovn.OvnCommand, error) {
+	ret := m.called("LSAdd", []interface{}{ls}, false, func() []interface{} {
+		r0, r1 := m.Fallback.LSAdd(ls)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) LSDel(ls string) (*goovn.OvnCommand, error) {
+	ret := m.called("LSDel", []interface{}{ls}, false, func() []interface{} {
+		r0, r1 := m.Fallback.LSDel(ls)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) LSList() ([]*goovn.LogicalSwitch, error) {
+	ret := m.called("LSList", []interface{}{}, false, func() []interface{} {
+		r0, r1 := m.Fallback.LSList()
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).([]*goovn.LogicalSwitch)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) LSExtIdsAdd(ls string, external_ids map[string]string) (*goovn.OvnCommand, error) {
+	ret := m.called("LSExtIdsAdd", []interface{}{ls, external_ids}, false, func() []interface{} {
+		r0, r1 := m.Fallback.LSExtIdsAdd(ls, external_ids)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) LSExtIdsDel(ls string, external_ids map[string]string) (*goovn.OvnCommand, error) {
+	ret := m.called("LSExtIdsDel", []interface{}{ls, external_ids}, false, func() []interface{} {
+		r0, r1 := m.Fallback.LSExtIdsDel(ls, external_ids)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) LinkSwitchToRouter(lsw string, lsp string, lr string, lrp string, lrpMac string, networks []string, externalIds map[string]string) (*goovn.OvnCommand, error) {
+	ret := m.called("LinkSwitchToRouter", []interface{}{lsw, lsp, lr, lrp, lrpMac, networks, externalIds}, false, func() []interface{} {
+		r0, r1 := m.Fallback.LinkSwitchToRouter(lsw, lsp, lr, lrp, lrpMac, networks, externalIds)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) LSPGet(lsp string) (*goovn.LogicalSwitchPort, error) {
+	ret := m.called("LSPGet", []interface{}{lsp}, false, func() []interface{} {
+		r0, r1 := m.Fallback.LSPGet(lsp)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.LogicalSwitchPort)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) LSPAdd(ls string, lsp string) (*goovn.OvnCommand, error) {
+	ret := m.called("LSPAdd", []interface{}{ls, lsp}, false, func() []interface{} {
+		r0, r1 := m.Fallback.LSPAdd(ls, lsp)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) LSPDel(lsp string) (*goovn.OvnCommand, error) {
+	ret := m.called("LSPDel", []interface{}{lsp}, false, func() []interface{} {
+		r0, r1 := m.Fallback.LSPDel(lsp)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) LSPSetAddress(lsp string, addresses ...string) (*goovn.OvnCommand, error) {
+	ret := m.called("LSPSetAddress", []interface{}{lsp, addresses}, false, func() []interface{} {
+		r0, r1 := m.Fallback.LSPSetAddress(lsp, addresses...)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) LSPSetPortSecurity(lsp string, security ...string) (*goovn.OvnCommand, error) {
+	ret := m.called("LSPSetPortSecurity", []interface{}{lsp, security}, false, func() []interface{} {
+		r0, r1 := m.Fallback.LSPSetPortSecurity(lsp, security...)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) LSPSetType(lsp string, portType string) (*goovn.OvnCommand, error) {
+	ret := m.called("LSPSetType", []interface{}{lsp, portType}, false, func() []interface{} {
+		r0, r1 := m.Fallback.LSPSetType(lsp, portType)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) LSPList(ls string) ([]*goovn.LogicalSwitchPort, error) {
+	ret := m.called("LSPList", []interface{}{ls}, false, func() []interface{} {
+		r0, r1 := m.Fallback.LSPList(ls)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).([]*goovn.LogicalSwitchPort)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) LSLBAdd(ls string, lb string) (*goovn.OvnCommand, error) {
+	ret := m.called("LSLBAdd", []interface{}{ls, lb}, false, func() []interface{} {
+		r0, r1 := m.Fallback.LSLBAdd(ls, lb)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) LSLBDel(ls string, lb string) (*goovn.OvnCommand, error) {
+	ret := m.called("LSLBDel", []interface{}{ls, lb}, false, func() []interface{} {
+		r0, r1 := m.Fallback.LSLBDel(ls, lb)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) LSLBList(ls string) ([]*goovn.LoadBalancer, error) {
+	ret := m.called("LSLBList", []interface{}{ls}, false, func() []interface{} {
+		r0, r1 := m.Fallback.LSLBList(ls)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).([]*goovn.LoadBalancer)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) ACLAddEntity(entityType goovn.EntityType, entityName string, aclName string, direct string, match string, action string, priority int, external_ids map[string]string, logflag bool, meter string, severity string) (*goovn.OvnCommand, error) {
+	ret := m.called("ACLAddEntity", []interface{}{entityType, entityName, aclName, direct, match, action, priority, external_ids, logflag, meter, severity}, false, func() []interface{} {
+		r0, r1 := m.Fallback.ACLAddEntity(entityType, entityName, aclName, direct, match, action, priority, external_ids, logflag, meter, severity)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) ACLAdd(ls string, direct string, match string, action string, priority int, external_ids map[string]string, logflag bool, meter string, severity string) (*goovn.OvnCommand, error) {
+	ret := m.called("ACLAdd", []interface{}{ls, direct, match, action, priority, external_ids, logflag, meter, severity}, false, func() []interface{} {
+		r0, r1 := m.Fallback.ACLAdd(ls, direct, match, action, priority, external_ids, logflag, meter, severity)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) ACLSetName(aclUUID string, aclName string) (*goovn.OvnCommand, error) {
+	ret := m.called("ACLSetName", []interface{}{aclUUID, aclName}, false, func() []interface{} {
+		r0, r1 := m.Fallback.ACLSetName(aclUUID, aclName)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) ACLSetMatch(aclUUID string, newMatch string) (*goovn.OvnCommand, error) {
+	ret := m.called("ACLSetMatch", []interface{}{aclUUID, newMatch}, false, func() []interface{} {
+		r0, r1 := m.Fallback.ACLSetMatch(aclUUID, newMatch)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) ACLSetLogging(aclUUID string, newLogflag bool, newMeter string, newSeverity string) (*goovn.OvnCommand, error) {
+	ret := m.called("ACLSetLogging", []interface{}{aclUUID, newLogflag, newMeter, newSeverity}, false, func() []interface{} {
+		r0, r1 := m.Fallback.ACLSetLogging(aclUUID, newLogflag, newMeter, newSeverity)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) ACLDelEntity(entityType goovn.EntityType, entityName string, aclUUID string) (*goovn.OvnCommand, error) {
+	ret := m.called("ACLDelEntity", []interface{}{entityType, entityName, aclUUID}, false, func() []interface{} {
+		r0, r1 := m.Fallback.ACLDelEntity(entityType, entityName, aclUUID)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) ACLDel(ls string, direct string, match string, priority int, external_ids map[string]string) (*goovn.OvnCommand, error) {
+	ret := m.called("ACLDel", []interface{}{ls, direct, match, priority, external_ids}, false, func() []interface{} {
+		r0, r1 := m.Fallback.ACLDel(ls, direct, match, priority, external_ids)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) ACLListEntity(entityType goovn.EntityType, entityName string) ([]*goovn.ACL, error) {
+	ret := m.called("ACLListEntity", []interface{}{entityType, entityName}, false, func() []interface{} {
+		r0, r1 := m.Fallback.ACLListEntity(entityType, entityName)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).([]*goovn.ACL)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) ACLList(ls string) ([]*goovn.ACL, error) {
+	ret := m.called("ACLList", []interface{}{ls}, false, func() []interface{} {
+		r0, r1 := m.Fallback.ACLList(ls)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).([]*goovn.ACL)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) ASGet(name string) (*goovn.AddressSet, error) {
+	ret := m.called("ASGet", []interface{}{name}, false, func() []interface{} {
+		r0, r1 := m.Fallback.ASGet(name)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.AddressSet)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) ASUpdate(name string, addrs []string, external_ids map[string]string) (*goovn.OvnCommand, error) {
+	ret := m.called("ASUpdate", []interface{}{name, addrs, external_ids}, false, func() []interface{} {
+		r0, r1 := m.Fallback.ASUpdate(name, addrs, external_ids)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) ASAdd(name string, addrs []string, external_ids map[string]string) (*goovn.OvnCommand, error) {
+	ret := m.called("ASAdd", []interface{}{name, addrs, external_ids}, false, func() []interface{} {
+		r0, r1 := m.Fallback.ASAdd(name, addrs, external_ids)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) ASDel(name string) (*goovn.OvnCommand, error) {
+	ret := m.called("ASDel", []interface{}{name}, false, func() []interface{} {
+		r0, r1 := m.Fallback.ASDel(name)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) ASList() ([]*goovn.AddressSet, error) {
+	ret := m.called("ASList", []interface{}{}, false, func() []interface{} {
+		r0, r1 := m.Fallback.ASList()
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).([]*goovn.AddressSet)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) LRGet(name string) ([]*goovn.LogicalRouter, error) {
+	ret := m.called("LRGet", []interface{}{name}, false, func() []interface{} {
+		r0, r1 := m.Fallback.LRGet(name)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).([]*goovn.LogicalRouter)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) LRAdd(name string, external_ids map[string]string) (*goovn.OvnCommand, error) {
+	ret := m.called("LRAdd", []interface{}{name, external_ids}, false, func() []interface{} {
+		r0, r1 := m.Fallback.LRAdd(name, external_ids)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) LRDel(name string) (*goovn.OvnCommand, error) {
+	ret := m.called("LRDel", []interface{}{name}, false, func() []interface{} {
+		r0, r1 := m.Fallback.LRDel(name)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) LRList() ([]*goovn.LogicalRouter, error) {
+	ret := m.called("LRList", []interface{}{}, false, func() []interface{} {
+		r0, r1 := m.Fallback.LRList()
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).([]*goovn.LogicalRouter)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) LRPAdd(lr string, lrp string, mac string, network []string, peer string, external_ids map[string]string) (*goovn.OvnCommand, error) {
+	ret := m.called("LRPAdd", []interface{}{lr, lrp, mac, network, peer, external_ids}, false, func() []interface{} {
+		r0, r1 := m.Fallback.LRPAdd(lr, lrp, mac, network, peer, external_ids)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) LRPDel(lr string, lrp string) (*goovn.OvnCommand, error) {
+	ret := m.called("LRPDel", []interface{}{lr, lrp}, false, func() []interface{} {
+		r0, r1 := m.Fallback.LRPDel(lr, lrp)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) LRPList(lr string) ([]*goovn.LogicalRouterPort, error) {
+	ret := m.called("LRPList", []interface{}{lr}, false, func() []interface{} {
+		r0, r1 := m.Fallback.LRPList(lr)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).([]*goovn.LogicalRouterPort)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) LRSRAdd(lr string, ip_prefix string, nexthop string, output_port *string, policy *string, external_ids map[string]string) (*goovn.OvnCommand, error) {
+	ret := m.called("LRSRAdd", []interface{}{lr, ip_prefix, nexthop, output_port, policy, external_ids}, false, func() []interface{} {
+		r0, r1 := m.Fallback.LRSRAdd(lr, ip_prefix, nexthop, output_port, policy, external_ids)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) LRSRDel(lr string, prefix string, nexthop *string, outputPort *string, policy *string) (*goovn.OvnCommand, error) {
+	ret := m.called("LRSRDel", []interface{}{lr, prefix, nexthop, outputPort, policy}, false, func() []interface{} {
+		r0, r1 := m.Fallback.LRSRDel(lr, prefix, nexthop, outputPort, policy)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) LRSRDelByUUID(lr string, uuid string) (*goovn.OvnCommand, error) {
+	ret := m.called("LRSRDelByUUID", []interface{}{lr, uuid}, false, func() []interface{} {
+		r0, r1 := m.Fallback.LRSRDelByUUID(lr, uuid)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) LRSRList(lr string) ([]*goovn.LogicalRouterStaticRoute, error) {
+	ret := m.called("LRSRList", []interface{}{lr}, false, func() []interface{} {
+		r0, r1 := m.Fallback.LRSRList(lr)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).([]*goovn.LogicalRouterStaticRoute)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) LRPolicyAdd(lr string, priority int, match string, action string, nexthop *string, nexthops []string, options map[string]string, external_ids map[string]string) (*goovn.OvnCommand, error) {
+	ret := m.called("LRPolicyAdd", []interface{}{lr, priority, match, action, nexthop, nexthops, options, external_ids}, false, func() []interface{} {
+		r0, r1 := m.Fallback.LRPolicyAdd(lr, priority, match, action, nexthop, nexthops, options, external_ids)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) LRPolicyDel(lr string, priority int, match *string) (*goovn.OvnCommand, error) {
+	ret := m.called("LRPolicyDel", []interface{}{lr, priority, match}, false, func() []interface{} {
+		r0, r1 := m.Fallback.LRPolicyDel(lr, priority, match)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) LRPolicyDelByUUID(lr string, uuid string) (*goovn.OvnCommand, error) {
+	ret := m.called("LRPolicyDelByUUID", []interface{}{lr, uuid}, false, func() []interface{} {
+		r0, r1 := m.Fallback.LRPolicyDelByUUID(lr, uuid)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) LRPolicyDelAll(lr string) (*goovn.OvnCommand, error) {
+	ret := m.called("LRPolicyDelAll", []interface{}{lr}, false, func() []interface{} {
+		r0, r1 := m.Fallback.LRPolicyDelAll(lr)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) LRPolicyList(lr string) ([]*goovn.LogicalRouterPolicy, error) {
+	ret := m.called("LRPolicyList", []interface{}{lr}, false, func() []interface{} {
+		r0, r1 := m.Fallback.LRPolicyList(lr)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).([]*goovn.LogicalRouterPolicy)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) LRLBAdd(lr string, lb string) (*goovn.OvnCommand, error) {
+	ret := m.called("LRLBAdd", []interface{}{lr, lb}, false, func() []interface{} {
+		r0, r1 := m.Fallback.LRLBAdd(lr, lb)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) LRLBDel(lr string, lb string) (*goovn.OvnCommand, error) {
+	ret := m.called("LRLBDel", []interface{}{lr, lb}, false, func() []interface{} {
+		r0, r1 := m.Fallback.LRLBDel(lr, lb)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) LRLBList(lr string) ([]*goovn.LoadBalancer, error) {
+	ret := m.called("LRLBList", []interface{}{lr}, false, func() []interface{} {
+		r0, r1 := m.Fallback.LRLBList(lr)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).([]*goovn.LoadBalancer)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) LBGet(name string) ([]*goovn.LoadBalancer, error) {
+	ret := m.called("LBGet", []interface{}{name}, false, func() []interface{} {
+		r0, r1 := m.Fallback.LBGet(name)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).([]*goovn.LoadBalancer)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) LBAdd(name string, vipPort string, protocol string, addrs []string) (*goovn.OvnCommand, error) {
+	ret := m.called("LBAdd", []interface{}{name, vipPort, protocol, addrs}, false, func() []interface{} {
+		r0, r1 := m.Fallback.LBAdd(name, vipPort, protocol, addrs)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) LBDel(name string) (*goovn.OvnCommand, error) {
+	ret := m.called("LBDel", []interface{}{name}, false, func() []interface{} {
+		r0, r1 := m.Fallback.LBDel(name)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) LBUpdate(name string, vipPort string, protocol string, addrs []string) (*goovn.OvnCommand, error) {
+	ret := m.called("LBUpdate", []interface{}{name, vipPort, protocol, addrs}, false, func() []interface{} {
+		r0, r1 := m.Fallback.LBUpdate(name, vipPort, protocol, addrs)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) LBSetSelectionFields(name string, selectionFields string) (*goovn.OvnCommand, error) {
+	ret := m.called("LBSetSelectionFields", []interface{}{name, selectionFields}, false, func() []interface{} {
+		r0, r1 := m.Fallback.LBSetSelectionFields(name, selectionFields)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) LBList() ([]*goovn.LoadBalancer, error) {
+	ret := m.called("LBList", []interface{}{}, false, func() []interface{} {
+		r0, r1 := m.Fallback.LBList()
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).([]*goovn.LoadBalancer)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) LSPSetDHCPv4Options(lsp string, options string) (*goovn.OvnCommand, error) {
+	ret := m.called("LSPSetDHCPv4Options", []interface{}{lsp, options}, false, func() []interface{} {
+		r0, r1 := m.Fallback.LSPSetDHCPv4Options(lsp, options)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) LSPGetDHCPv4Options(lsp string) (*goovn.DHCPOptions, error) {
+	ret := m.called("LSPGetDHCPv4Options", []interface{}{lsp}, false, func() []interface{} {
+		r0, r1 := m.Fallback.LSPGetDHCPv4Options(lsp)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.DHCPOptions)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) LSPSetDHCPv6Options(lsp string, options string) (*goovn.OvnCommand, error) {
+	ret := m.called("LSPSetDHCPv6Options", []interface{}{lsp, options}, false, func() []interface{} {
+		r0, r1 := m.Fallback.LSPSetDHCPv6Options(lsp, options)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) LSPGetDHCPv6Options(lsp string) (*goovn.DHCPOptions, error) {
+	ret := m.called("LSPGetDHCPv6Options", []interface{}{lsp}, false, func() []interface{} {
+		r0, r1 := m.Fallback.LSPGetDHCPv6Options(lsp)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.DHCPOptions)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) LSPSetOptions(lsp string, options map[string]string) (*goovn.OvnCommand, error) {
+	ret := m.called("LSPSetOptions", []interface{}{lsp, options}, false, func() []interface{} {
+		r0, r1 := m.Fallback.LSPSetOptions(lsp, options)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) LSPGetOptions(lsp string) (map[string]string, error) {
+	ret := m.called("LSPGetOptions", []interface{}{lsp}, false, func() []interface{} {
+		r0, r1 := m.Fallback.LSPGetOptions(lsp)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(map[string]string)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) LSPSetDynamicAddresses(lsp string, address string) (*goovn.OvnCommand, error) {
+	ret := m.called("LSPSetDynamicAddresses", []interface{}{lsp, address}, false, func() []interface{} {
+		r0, r1 := m.Fallback.LSPSetDynamicAddresses(lsp, address)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) LSPGetDynamicAddresses(lsp string) (string, error) {
+	ret := m.called("LSPGetDynamicAddresses", []interface{}{lsp}, false, func() []interface{} {
+		r0, r1 := m.Fallback.LSPGetDynamicAddresses(lsp)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(string)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) LSPSetExternalIds(lsp string, external_ids map[string]string) (*goovn.OvnCommand, error) {
+	ret := m.called("LSPSetExternalIds", []interface{}{lsp, external_ids}, false, func() []interface{} {
+		r0, r1 := m.Fallback.LSPSetExternalIds(lsp, external_ids)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) LSPGetExternalIds(lsp string) (map[string]string, error) {
+	ret := m.called("LSPGetExternalIds", []interface{}{lsp}, false, func() []interface{} {
+		r0, r1 := m.Fallback.LSPGetExternalIds(lsp)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(map[string]string)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) DHCPOptionsAdd(cidr string, options map[string]string, external_ids map[string]string) (*goovn.OvnCommand, error) {
+	ret := m.called("DHCPOptionsAdd", []interface{}{cidr, options, external_ids}, false, func() []interface{} {
+		r0, r1 := m.Fallback.DHCPOptionsAdd(cidr, options, external_ids)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) DHCPOptionsSet(uuid string, options map[string]string, external_ids map[string]string) (*goovn.OvnCommand, error) {
+	ret := m.called("DHCPOptionsSet", []interface{}{uuid, options, external_ids}, false, func() []interface{} {
+		r0, r1 := m.Fallback.DHCPOptionsSet(uuid, options, external_ids)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) DHCPOptionsDel(uuid string) (*goovn.OvnCommand, error) {
+	ret := m.called("DHCPOptionsDel", []interface{}{uuid}, false, func() []interface{} {
+		r0, r1 := m.Fallback.DHCPOptionsDel(uuid)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) DHCPOptionsGet(uuid string) (*goovn.DHCPOptions, error) {
+	ret := m.called("DHCPOptionsGet", []interface{}{uuid}, false, func() []interface{} {
+		r0, r1 := m.Fallback.DHCPOptionsGet(uuid)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.DHCPOptions)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) DHCPOptionsList() ([]*goovn.DHCPOptions, error) {
+	ret := m.called("DHCPOptionsList", []interface{}{}, false, func() []interface{} {
+		r0, r1 := m.Fallback.DHCPOptionsList()
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).([]*goovn.DHCPOptions)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) QoSAdd(ls string, direction string, priority int, match string, action map[string]int, bandwidth map[string]int, external_ids map[string]string) (*goovn.OvnCommand, error) {
+	ret := m.called("QoSAdd", []interface{}{ls, direction, priority, match, action, bandwidth, external_ids}, false, func() []interface{} {
+		r0, r1 := m.Fallback.QoSAdd(ls, direction, priority, match, action, bandwidth, external_ids)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) QoSDel(ls string, direction string, priority int, match string) (*goovn.OvnCommand, error) {
+	ret := m.called("QoSDel", []interface{}{ls, direction, priority, match}, false, func() []interface{} {
+		r0, r1 := m.Fallback.QoSDel(ls, direction, priority, match)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) QoSList(ls string) ([]*goovn.QoS, error) {
+	ret := m.called("QoSList", []interface{}{ls}, false, func() []interface{} {
+		r0, r1 := m.Fallback.QoSList(ls)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).([]*goovn.QoS)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) LRNATAdd(lr string, ntype string, externalIp string, logicalIp string, external_ids map[string]string, logicalPortAndExternalMac ...string) (*goovn.OvnCommand, error) {
+	ret := m.called("LRNATAdd", []interface{}{lr, ntype, externalIp, logicalIp, external_ids, logicalPortAndExternalMac}, false, func() []interface{} {
+		r0, r1 := m.Fallback.LRNATAdd(lr, ntype, externalIp, logicalIp, external_ids, logicalPortAndExternalMac...)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) LRNATDel(lr string, ntype string, ip ...string) (*goovn.OvnCommand, error) {
+	ret := m.called("LRNATDel", []interface{}{lr, ntype, ip}, false, func() []interface{} {
+		r0, r1 := m.Fallback.LRNATDel(lr, ntype, ip...)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) LRNATList(lr string) ([]*goovn.NAT, error) {
+	ret := m.called("LRNATList", []interface{}{lr}, false, func() []interface{} {
+		r0, r1 := m.Fallback.LRNATList(lr)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).([]*goovn.NAT)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) MeterAdd(name string, action string, rate int, unit string, external_ids map[string]string, burst int) (*goovn.OvnCommand, error) {
+	ret := m.called("MeterAdd", []interface{}{name, action, rate, unit, external_ids, burst}, false, func() []interface{} {
+		r0, r1 := m.Fallback.MeterAdd(name, action, rate, unit, external_ids, burst)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) MeterDel(name ...string) (*goovn.OvnCommand, error) {
+	ret := m.called("MeterDel", []interface{}{name}, false, func() []interface{} {
+		r0, r1 := m.Fallback.MeterDel(name...)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) MeterList() ([]*goovn.Meter, error) {
+	ret := m.called("MeterList", []interface{}{}, false, func() []interface{} {
+		r0, r1 := m.Fallback.MeterList()
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).([]*goovn.Meter)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) MeterBandsList() ([]*goovn.MeterBand, error) {
+	ret := m.called("MeterBandsList", []interface{}{}, false, func() []interface{} {
+		r0, r1 := m.Fallback.MeterBandsList()
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).([]*goovn.MeterBand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) Execute(cmds ...*goovn.OvnCommand) error {
+	m.record(cmds)
+	ret := m.called("Execute", []interface{}{cmds}, true, func() []interface{} {
+		r0 := m.Fallback.Execute(cmds...)
+		return []interface{}{r0}
+	})
+	r0, _ := ret.get(0).(error)
+	return r0
+}
+
+func (m *Client) ExecuteR(cmds ...*goovn.OvnCommand) ([]string, error) {
+	m.record(cmds)
+	ret := m.called("ExecuteR", []interface{}{cmds}, true, func() []interface{} {
+		r0, r1 := m.Fallback.ExecuteR(cmds...)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).([]string)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) ExecuteContext(ctx context.Context, cmds ...*goovn.OvnCommand) error {
+	m.record(cmds)
+	ret := m.called("ExecuteContext", []interface{}{ctx, cmds}, true, func() []interface{} {
+		r0 := m.Fallback.ExecuteContext(ctx, cmds...)
+		return []interface{}{r0}
+	})
+	r0, _ := ret.get(0).(error)
+	return r0
+}
+
+func (m *Client) ExecuteRContext(ctx context.Context, cmds ...*goovn.OvnCommand) ([]string, error) {
+	m.record(cmds)
+	ret := m.called("ExecuteRContext", []interface{}{ctx, cmds}, true, func() []interface{} {
+		r0, r1 := m.Fallback.ExecuteRContext(ctx, cmds...)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).([]string)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) NewTransaction() *goovn.Transaction {
+	ret := m.called("NewTransaction", []interface{}{}, false, func() []interface{} {
+		r0 := m.Fallback.NewTransaction()
+		return []interface{}{r0}
+	})
+	r0, _ := ret.get(0).(*goovn.Transaction)
+	if r0 == nil {
+		m.noTransaction()
+	}
+	return r0
+}
+
+func (m *Client) ChassisAdd(name string, hostname string, etype []string, ip string, external_ids map[string]string, transport_zones []string, vtep_lswitches []string) (*goovn.OvnCommand, error) {
+	ret := m.called("ChassisAdd", []interface{}{name, hostname, etype, ip, external_ids, transport_zones, vtep_lswitches}, false, func() []interface{} {
+		r0, r1 := m.Fallback.ChassisAdd(name, hostname, etype, ip, external_ids, transport_zones, vtep_lswitches)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) ChassisDel(chName string) (*goovn.OvnCommand, error) {
+	ret := m.called("ChassisDel", []interface{}{chName}, false, func() []interface{} {
+		r0, r1 := m.Fallback.ChassisDel(chName)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) ChassisGet(chname string) ([]*goovn.Chassis, error) {
+	ret := m.called("ChassisGet", []interface{}{chname}, false, func() []interface{} {
+		r0, r1 := m.Fallback.ChassisGet(chname)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).([]*goovn.Chassis)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) ChassisList() ([]*goovn.Chassis, error) {
+	ret := m.called("ChassisList", []interface{}{}, false, func() []interface{} {
+		r0, r1 := m.Fallback.ChassisList()
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).([]*goovn.Chassis)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) ChassisPrivateDel(chName string) (*goovn.OvnCommand, error) {
+	ret := m.called("ChassisPrivateDel", []interface{}{chName}, false, func() []interface{} {
+		r0, r1 := m.Fallback.ChassisPrivateDel(chName)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) ChassisPrivateList() ([]*goovn.ChassisPrivate, error) {
+	ret := m.called("ChassisPrivateList", []interface{}{}, false, func() []interface{} {
+		r0, r1 := m.Fallback.ChassisPrivateList()
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).([]*goovn.ChassisPrivate)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) ChassisPrivateGet(chName string) ([]*goovn.ChassisPrivate, error) {
+	ret := m.called("ChassisPrivateGet", []interface{}{chName}, false, func() []interface{} {
+		r0, r1 := m.Fallback.ChassisPrivateGet(chName)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).([]*goovn.ChassisPrivate)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) EncapList(chname string) ([]*goovn.Encap, error) {
+	ret := m.called("EncapList", []interface{}{chname}, false, func() []interface{} {
+		r0, r1 := m.Fallback.EncapList(chname)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).([]*goovn.Encap)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) PortBindingList() ([]*goovn.PortBinding, error) {
+	ret := m.called("PortBindingList", []interface{}{}, false, func() []interface{} {
+		r0, r1 := m.Fallback.PortBindingList()
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).([]*goovn.PortBinding)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) PortBindingGet(uuid string) (*goovn.PortBinding, error) {
+	ret := m.called("PortBindingGet", []interface{}{uuid}, false, func() []interface{} {
+		r0, r1 := m.Fallback.PortBindingGet(uuid)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.PortBinding)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) PortBindingGetByLogicalPort(lport string) (*goovn.PortBinding, error) {
+	ret := m.called("PortBindingGetByLogicalPort", []interface{}{lport}, false, func() []interface{} {
+		r0, r1 := m.Fallback.PortBindingGetByLogicalPort(lport)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.PortBinding)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) PortBindingListByChassis(chassis string) ([]*goovn.PortBinding, error) {
+	ret := m.called("PortBindingListByChassis", []interface{}{chassis}, false, func() []interface{} {
+		r0, r1 := m.Fallback.PortBindingListByChassis(chassis)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).([]*goovn.PortBinding)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) DatapathBindingList() ([]*goovn.DatapathBinding, error) {
+	ret := m.called("DatapathBindingList", []interface{}{}, false, func() []interface{} {
+		r0, r1 := m.Fallback.DatapathBindingList()
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).([]*goovn.DatapathBinding)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) DatapathBindingGet(name string) ([]*goovn.DatapathBinding, error) {
+	ret := m.called("DatapathBindingGet", []interface{}{name}, false, func() []interface{} {
+		r0, r1 := m.Fallback.DatapathBindingGet(name)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).([]*goovn.DatapathBinding)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) LogicalFlowList(datapath string, pipeline string, tableID *int, stageName string) ([]*goovn.LogicalFlow, error) {
+	ret := m.called("LogicalFlowList", []interface{}{datapath, pipeline, tableID, stageName}, false, func() []interface{} {
+		r0, r1 := m.Fallback.LogicalFlowList(datapath, pipeline, tableID, stageName)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).([]*goovn.LogicalFlow)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) DNSAdd(records map[string]string, external_ids map[string]string) (*goovn.OvnCommand, error) {
+	ret := m.called("DNSAdd", []interface{}{records, external_ids}, false, func() []interface{} {
+		r0, r1 := m.Fallback.DNSAdd(records, external_ids)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) DNSDel(uuid string) (*goovn.OvnCommand, error) {
+	ret := m.called("DNSDel", []interface{}{uuid}, false, func() []interface{} {
+		r0, r1 := m.Fallback.DNSDel(uuid)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) DNSGet(uuid string) (*goovn.DNS, error) {
+	ret := m.called("DNSGet", []interface{}{uuid}, false, func() []interface{} {
+		r0, r1 := m.Fallback.DNSGet(uuid)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.DNS)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) DNSList() ([]*goovn.DNS, error) {
+	ret := m.called("DNSList", []interface{}{}, false, func() []interface{} {
+		r0, r1 := m.Fallback.DNSList()
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).([]*goovn.DNS)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) DNSSetRecord(uuid string, hostname string, ips ...string) (*goovn.OvnCommand, error) {
+	ret := m.called("DNSSetRecord", []interface{}{uuid, hostname, ips}, false, func() []interface{} {
+		r0, r1 := m.Fallback.DNSSetRecord(uuid, hostname, ips...)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) DNSRemoveRecord(uuid string, hostname string) (*goovn.OvnCommand, error) {
+	ret := m.called("DNSRemoveRecord", []interface{}{uuid, hostname}, false, func() []interface{} {
+		r0, r1 := m.Fallback.DNSRemoveRecord(uuid, hostname)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) LSDNSAdd(ls string, uuid string) (*goovn.OvnCommand, error) {
+	ret := m.called("LSDNSAdd", []interface{}{ls, uuid}, false, func() []interface{} {
+		r0, r1 := m.Fallback.LSDNSAdd(ls, uuid)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) LSDNSDel(ls string, uuid string) (*goovn.OvnCommand, error) {
+	ret := m.called("LSDNSDel", []interface{}{ls, uuid}, false, func() []interface{} {
+		r0, r1 := m.Fallback.LSDNSDel(ls, uuid)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) LSDNSList(ls string) ([]*goovn.DNS, error) {
+	ret := m.called("LSDNSList", []interface{}{ls}, false, func() []interface{} {
+		r0, r1 := m.Fallback.LSDNSList(ls)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).([]*goovn.DNS)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) LRPSetGatewayChassis(lrp string, chassis string, priority int) (*goovn.OvnCommand, error) {
+	ret := m.called("LRPSetGatewayChassis", []interface{}{lrp, chassis, priority}, false, func() []interface{} {
+		r0, r1 := m.Fallback.LRPSetGatewayChassis(lrp, chassis, priority)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) LRPDelGatewayChassis(lrp string, chassis string) (*goovn.OvnCommand, error) {
+	ret := m.called("LRPDelGatewayChassis", []interface{}{lrp, chassis}, false, func() []interface{} {
+		r0, r1 := m.Fallback.LRPDelGatewayChassis(lrp, chassis)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) LRPGatewayChassisList(lrp string) ([]*goovn.GatewayChassis, error) {
+	ret := m.called("LRPGatewayChassisList", []interface{}{lrp}, false, func() []interface{} {
+		r0, r1 := m.Fallback.LRPGatewayChassisList(lrp)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).([]*goovn.GatewayChassis)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) LRPSetHAChassisGroup(lrp string, group string) (*goovn.OvnCommand, error) {
+	ret := m.called("LRPSetHAChassisGroup", []interface{}{lrp, group}, false, func() []interface{} {
+		r0, r1 := m.Fallback.LRPSetHAChassisGroup(lrp, group)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) HAChassisGroupAdd(group string, external_ids map[string]string) (*goovn.OvnCommand, error) {
+	ret := m.called("HAChassisGroupAdd", []interface{}{group, external_ids}, false, func() []interface{} {
+		r0, r1 := m.Fallback.HAChassisGroupAdd(group, external_ids)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) HAChassisGroupDel(group string) (*goovn.OvnCommand, error) {
+	ret := m.called("HAChassisGroupDel", []interface{}{group}, false, func() []interface{} {
+		r0, r1 := m.Fallback.HAChassisGroupDel(group)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) HAChassisGroupGet(group string) (*goovn.HAChassisGroup, error) {
+	ret := m.called("HAChassisGroupGet", []interface{}{group}, false, func() []interface{} {
+		r0, r1 := m.Fallback.HAChassisGroupGet(group)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.HAChassisGroup)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) HAChassisGroupList() ([]*goovn.HAChassisGroup, error) {
+	ret := m.called("HAChassisGroupList", []interface{}{}, false, func() []interface{} {
+		r0, r1 := m.Fallback.HAChassisGroupList()
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).([]*goovn.HAChassisGroup)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) HAChassisGroupAddChassis(group string, chassis string, priority int) (*goovn.OvnCommand, error) {
+	ret := m.called("HAChassisGroupAddChassis", []interface{}{group, chassis, priority}, false, func() []interface{} {
+		r0, r1 := m.Fallback.HAChassisGroupAddChassis(group, chassis, priority)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) HAChassisGroupRemoveChassis(group string, chassis string) (*goovn.OvnCommand, error) {
+	ret := m.called("HAChassisGroupRemoveChassis", []interface{}{group, chassis}, false, func() []interface{} {
+		r0, r1 := m.Fallback.HAChassisGroupRemoveChassis(group, chassis)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) NBGlobalSetOptions(options map[string]string) (*goovn.OvnCommand, error) {
+	ret := m.called("NBGlobalSetOptions", []interface{}{options}, false, func() []interface{} {
+		r0, r1 := m.Fallback.NBGlobalSetOptions(options)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) NBGlobalGetOptions() (map[string]string, error) {
+	ret := m.called("NBGlobalGetOptions", []interface{}{}, false, func() []interface{} {
+		r0, r1 := m.Fallback.NBGlobalGetOptions()
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(map[string]string)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) SBGlobalSetOptions(options map[string]string) (*goovn.OvnCommand, error) {
+	ret := m.called("SBGlobalSetOptions", []interface{}{options}, false, func() []interface{} {
+		r0, r1 := m.Fallback.SBGlobalSetOptions(options)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) SBGlobalGetOptions() (map[string]string, error) {
+	ret := m.called("SBGlobalGetOptions", []interface{}{}, false, func() []interface{} {
+		r0, r1 := m.Fallback.SBGlobalGetOptions()
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(map[string]string)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) LSGetContext(ctx context.Context, ls string) ([]*goovn.LogicalSwitch, error) {
+	ret := m.called("LSGetContext", []interface{}{ctx, ls}, false, func() []interface{} {
+		r0, r1 := m.Fallback.LSGetContext(ctx, ls)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).([]*goovn.LogicalSwitch)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) LSListContext(ctx context.Context) ([]*goovn.LogicalSwitch, error) {
+	ret := m.called("LSListContext", []interface{}{ctx}, false, func() []interface{} {
+		r0, r1 := m.Fallback.LSListContext(ctx)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).([]*goovn.LogicalSwitch)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) LSPGetContext(ctx context.Context, lsp string) (*goovn.LogicalSwitchPort, error) {
+	ret := m.called("LSPGetContext", []interface{}{ctx, lsp}, false, func() []interface{} {
+		r0, r1 := m.Fallback.LSPGetContext(ctx, lsp)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.LogicalSwitchPort)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) LSPListContext(ctx context.Context, ls string) ([]*goovn.LogicalSwitchPort, error) {
+	ret := m.called("LSPListContext", []interface{}{ctx, ls}, false, func() []interface{} {
+		r0, r1 := m.Fallback.LSPListContext(ctx, ls)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).([]*goovn.LogicalSwitchPort)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) ACLListContext(ctx context.Context, ls string) ([]*goovn.ACL, error) {
+	ret := m.called("ACLListContext", []interface{}{ctx, ls}, false, func() []interface{} {
+		r0, r1 := m.Fallback.ACLListContext(ctx, ls)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).([]*goovn.ACL)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) ASGetContext(ctx context.Context, name string) (*goovn.AddressSet, error) {
+	ret := m.called("ASGetContext", []interface{}{ctx, name}, false, func() []interface{} {
+		r0, r1 := m.Fallback.ASGetContext(ctx, name)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.AddressSet)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) ASListContext(ctx context.Context) ([]*goovn.AddressSet, error) {
+	ret := m.called("ASListContext", []interface{}{ctx}, false, func() []interface{} {
+		r0, r1 := m.Fallback.ASListContext(ctx)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).([]*goovn.AddressSet)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) LRGetContext(ctx context.Context, name string) ([]*goovn.LogicalRouter, error) {
+	ret := m.called("LRGetContext", []interface{}{ctx, name}, false, func() []interface{} {
+		r0, r1 := m.Fallback.LRGetContext(ctx, name)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).([]*goovn.LogicalRouter)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) LRListContext(ctx context.Context) ([]*goovn.LogicalRouter, error) {
+	ret := m.called("LRListContext", []interface{}{ctx}, false, func() []interface{} {
+		r0, r1 := m.Fallback.LRListContext(ctx)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).([]*goovn.LogicalRouter)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) LRPListContext(ctx context.Context, lr string) ([]*goovn.LogicalRouterPort, error) {
+	ret := m.called("LRPListContext", []interface{}{ctx, lr}, false, func() []interface{} {
+		r0, r1 := m.Fallback.LRPListContext(ctx, lr)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).([]*goovn.LogicalRouterPort)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) LBGetContext(ctx context.Context, name string) ([]*goovn.LoadBalancer, error) {
+	ret := m.called("LBGetContext", []interface{}{ctx, name}, false, func() []interface{} {
+		r0, r1 := m.Fallback.LBGetContext(ctx, name)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).([]*goovn.LoadBalancer)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) LBListContext(ctx context.Context) ([]*goovn.LoadBalancer, error) {
+	ret := m.called("LBListContext", []interface{}{ctx}, false, func() []interface{} {
+		r0, r1 := m.Fallback.LBListContext(ctx)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).([]*goovn.LoadBalancer)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) PortGroupGetContext(ctx context.Context, group string) (*goovn.PortGroup, error) {
+	ret := m.called("PortGroupGetContext", []interface{}{ctx, group}, false, func() []interface{} {
+		r0, r1 := m.Fallback.PortGroupGetContext(ctx, group)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.PortGroup)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) ChassisGetContext(ctx context.Context, name string) ([]*goovn.Chassis, error) {
+	ret := m.called("ChassisGetContext", []interface{}{ctx, name}, false, func() []interface{} {
+		r0, r1 := m.Fallback.ChassisGetContext(ctx, name)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).([]*goovn.Chassis)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) ChassisListContext(ctx context.Context) ([]*goovn.Chassis, error) {
+	ret := m.called("ChassisListContext", []interface{}{ctx}, false, func() []interface{} {
+		r0, r1 := m.Fallback.ChassisListContext(ctx)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).([]*goovn.Chassis)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) NBGlobalGet() (*goovn.NBGlobalTableRow, error) {
+	ret := m.called("NBGlobalGet", []interface{}{}, false, func() []interface{} {
+		r0, r1 := m.Fallback.NBGlobalGet()
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.NBGlobalTableRow)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) SBGlobalGet() (*goovn.SBGlobalTableRow, error) {
+	ret := m.called("SBGlobalGet", []interface{}{}, false, func() []interface{} {
+		r0, r1 := m.Fallback.SBGlobalGet()
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.SBGlobalTableRow)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) WaitForSync(ctx context.Context, level goovn.SyncLevel, cmds ...*goovn.OvnCommand) error {
+	m.record(cmds)
+	ret := m.called("WaitForSync", []interface{}{ctx, level, cmds}, true, func() []interface{} {
+		r0 := m.Fallback.WaitForSync(ctx, level, cmds...)
+		return []interface{}{r0}
+	})
+	r0, _ := ret.get(0).(error)
+	return r0
+}
+
+func (m *Client) ModelGet(uuid string, model interface{}) error {
+	ret := m.called("ModelGet", []interface{}{uuid, model}, false, func() []interface{} {
+		r0 := m.Fallback.ModelGet(uuid, model)
+		return []interface{}{r0}
+	})
+	r0, _ := ret.get(0).(error)
+	return r0
+}
+
+func (m *Client) ModelList(result interface{}) error {
+	ret := m.called("ModelList", []interface{}{result}, false, func() []interface{} {
+		r0 := m.Fallback.ModelList(result)
+		return []interface{}{r0}
+	})
+	r0, _ := ret.get(0).(error)
+	return r0
+}
+
+func (m *Client) ModelAdd(model interface{}) (*goovn.OvnCommand, error) {
+	ret := m.called("ModelAdd", []interface{}{model}, false, func() []interface{} {
+		r0, r1 := m.Fallback.ModelAdd(model)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) ModelUpdate(model interface{}, columns ...string) (*goovn.OvnCommand, error) {
+	ret := m.called("ModelUpdate", []interface{}{model, columns}, false, func() []interface{} {
+		r0, r1 := m.Fallback.ModelUpdate(model, columns...)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) ModelDel(model interface{}) (*goovn.OvnCommand, error) {
+	ret := m.called("ModelDel", []interface{}{model}, false, func() []interface{} {
+		r0, r1 := m.Fallback.ModelDel(model)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) Reconcile(ctx context.Context, desired *goovn.Topology, ownerKey string) (*goovn.ReconcileReport, error) {
+	ret := m.called("Reconcile", []interface{}{ctx, desired, ownerKey}, false, func() []interface{} {
+		r0, r1 := m.Fallback.Reconcile(ctx, desired, ownerKey)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.ReconcileReport)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) ReconcileDryRun(desired *goovn.Topology, ownerKey string) (*goovn.ReconcileReport, error) {
+	ret := m.called("ReconcileDryRun", []interface{}{desired, ownerKey}, false, func() []interface{} {
+		r0, r1 := m.Fallback.ReconcileDryRun(desired, ownerKey)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.ReconcileReport)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) Snapshot() (*goovn.Snapshot, error) {
+	ret := m.called("Snapshot", []interface{}{}, false, func() []interface{} {
+		r0, r1 := m.Fallback.Snapshot()
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.Snapshot)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) Import(ctx context.Context, snapshot *goovn.Snapshot, batchSize int) error {
+	ret := m.called("Import", []interface{}{ctx, snapshot, batchSize}, false, func() []interface{} {
+		r0 := m.Fallback.Import(ctx, snapshot, batchSize)
+		return []interface{}{r0}
+	})
+	r0, _ := ret.get(0).(error)
+	return r0
+}
+
+func (m *Client) ConnectionSet(conns ...*goovn.Connection) (*goovn.OvnCommand, error) {
+	ret := m.called("ConnectionSet", []interface{}{conns}, false, func() []interface{} {
+		r0, r1 := m.Fallback.ConnectionSet(conns...)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) ConnectionDel() (*goovn.OvnCommand, error) {
+	ret := m.called("ConnectionDel", []interface{}{}, false, func() []interface{} {
+		r0, r1 := m.Fallback.ConnectionDel()
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) ConnectionGet() ([]*goovn.Connection, error) {
+	ret := m.called("ConnectionGet", []interface{}{}, false, func() []interface{} {
+		r0, r1 := m.Fallback.ConnectionGet()
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).([]*goovn.Connection)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) SSLSet(ssl *goovn.SSL) (*goovn.OvnCommand, error) {
+	ret := m.called("SSLSet", []interface{}{ssl}, false, func() []interface{} {
+		r0, r1 := m.Fallback.SSLSet(ssl)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) SSLDel() (*goovn.OvnCommand, error) {
+	ret := m.called("SSLDel", []interface{}{}, false, func() []interface{} {
+		r0, r1 := m.Fallback.SSLDel()
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) SSLGet() (*goovn.SSL, error) {
+	ret := m.called("SSLGet", []interface{}{}, false, func() []interface{} {
+		r0, r1 := m.Fallback.SSLGet()
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.SSL)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) PortGroupAdd(group string, ports []string, external_ids map[string]string) (*goovn.OvnCommand, error) {
+	ret := m.called("PortGroupAdd", []interface{}{group, ports, external_ids}, false, func() []interface{} {
+		r0, r1 := m.Fallback.PortGroupAdd(group, ports, external_ids)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) PortGroupUpdate(group string, ports []string, external_ids map[string]string) (*goovn.OvnCommand, error) {
+	ret := m.called("PortGroupUpdate", []interface{}{group, ports, external_ids}, false, func() []interface{} {
+		r0, r1 := m.Fallback.PortGroupUpdate(group, ports, external_ids)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) PortGroupAddPort(group string, port string) (*goovn.OvnCommand, error) {
+	ret := m.called("PortGroupAddPort", []interface{}{group, port}, false, func() []interface{} {
+		r0, r1 := m.Fallback.PortGroupAddPort(group, port)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) PortGroupRemovePort(group string, port string) (*goovn.OvnCommand, error) {
+	ret := m.called("PortGroupRemovePort", []interface{}{group, port}, false, func() []interface{} {
+		r0, r1 := m.Fallback.PortGroupRemovePort(group, port)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) PortGroupDel(group string) (*goovn.OvnCommand, error) {
+	ret := m.called("PortGroupDel", []interface{}{group}, false, func() []interface{} {
+		r0, r1 := m.Fallback.PortGroupDel(group)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) PortGroupGet(group string) (*goovn.PortGroup, error) {
+	ret := m.called("PortGroupGet", []interface{}{group}, false, func() []interface{} {
+		r0, r1 := m.Fallback.PortGroupGet(group)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.PortGroup)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) AddEventHandler(table string, filter goovn.EventFilter, handler goovn.EventHandler) (goovn.EventHandlerID, error) {
+	ret := m.called("AddEventHandler", []interface{}{table, filter, handler}, false, func() []interface{} {
+		r0, r1 := m.Fallback.AddEventHandler(table, filter, handler)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(goovn.EventHandlerID)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) RemoveEventHandler(id goovn.EventHandlerID) error {
+	ret := m.called("RemoveEventHandler", []interface{}{id}, false, func() []interface{} {
+		r0 := m.Fallback.RemoveEventHandler(id)
+		return []interface{}{r0}
+	})
+	r0, _ := ret.get(0).(error)
+	return r0
+}
+
+func (m *Client) Close() error {
+	ret := m.called("Close", []interface{}{}, false, func() []interface{} {
+		r0 := m.Fallback.Close()
+		return []interface{}{r0}
+	})
+	r0, _ := ret.get(0).(error)
+	return r0
+}
+
+func (m *Client) Connected() bool {
+	ret := m.called("Connected", []interface{}{}, false, func() []interface{} {
+		r0 := m.Fallback.Connected()
+		return []interface{}{r0}
+	})
+	r0, _ := ret.get(0).(bool)
+	return r0
+}
+
+func (m *Client) GetSchema() libovsdb.DatabaseSchema {
+	ret := m.called("GetSchema", []interface{}{}, false, func() []interface{} {
+		r0 := m.Fallback.GetSchema()
+		return []interface{}{r0}
+	})
+	r0, _ := ret.get(0).(libovsdb.DatabaseSchema)
+	return r0
+}
+
+func (m *Client) AuxKeyValSet(table string, rowName string, auxCol string, kv map[string]string) (*goovn.OvnCommand, error) {
+	ret := m.called("AuxKeyValSet", []interface{}{table, rowName, auxCol, kv}, false, func() []interface{} {
+		r0, r1 := m.Fallback.AuxKeyValSet(table, rowName, auxCol, kv)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
+
+func (m *Client) AuxKeyValDel(table string, rowName string, auxCol string, kv map[string]*string) (*goovn.OvnCommand, error) {
+	ret := m.called("AuxKeyValDel", []interface{}{table, rowName, auxCol, kv}, false, func() []interface{} {
+		r0, r1 := m.Fallback.AuxKeyValDel(table, rowName, auxCol, kv)
+		return []interface{}{r0, r1}
+	})
+	r0, _ := ret.get(0).(*goovn.OvnCommand)
+	r1, _ := ret.get(1).(error)
+	return r0, r1
+}
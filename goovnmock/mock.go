@@ -0,0 +1,190 @@
+/**
+ * Copyright (c) 2020 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+// Package goovnmock provides Client, a mock of the goovn.Client interface for
+// unit tests of code using goovn, built on the mock package of testify.
+//
+// The results of a method are programmed with On, and the operations of the
+// commands given to Execute and the other methods writing commands are
+// recorded, so that a test can check exactly what would have been written:
+//
+//	m := goovnmock.NewClient(t)
+//	m.On("LSGet", "ls1").Return([]*goovn.LogicalSwitch{{Name: "ls1"}}, nil)
+//	m.On("Execute", mock.Anything).Return(nil)
+//	...
+//	m.AssertExpectations(t)
+//	ops := m.Operations()
+//
+// A recorder is a mock building real commands with another client, e.g. one
+// connected to a goovntest server, but recording every write instead of
+// sending it, including the commits of transactions, Reconcile and Import.
+package goovnmock
+
+//go:generate go run ../cmd/mockgen -src ../client.go -out client.go
+
+import (
+	"fmt"
+	"sync"
+
+	goovn "github.com/ebay/go-ovn"
+	"github.com/ebay/go-ovn/internal/transact"
+	"github.com/ebay/libovsdb"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+// results are the results of a call, missing results are zero values
+type results []interface{}
+
+func (r results) get(i int) interface{} {
+	if i < len(r) {
+		return r[i]
+	}
+	return nil
+}
+
+// Client is a mock of goovn.Client. The calls of a method with an
+// expectation set with On are handled by the embedded mock.Mock, the calls
+// of the other methods are passed to Fallback and otherwise fail the test.
+type Client struct {
+	mock.Mock
+	// Fallback handles the calls of the methods without expectations
+	Fallback goovn.Client
+	// Record makes the methods writing commands, e.g. Execute, succeed
+	// without writing them if they have no expectations
+	Record bool
+
+	t        mock.TestingT
+	mu       sync.Mutex
+	executed [][]libovsdb.Operation
+}
+
+// NewClient creates a mock reporting unexpected calls to t
+func NewClient(t mock.TestingT) *Client {
+	m := &Client{t: t}
+	m.Test(t)
+	return m
+}
+
+// NewRecorder creates a mock passing calls to client, except that the
+// commands given to Execute and the other methods writing commands are
+// recorded but not written. The other writes of client, e.g. the commit of
+// a transaction, Reconcile and Import, are recorded by transact request and
+// answered as successful instead of being sent, so client must not be used
+// to write to the db anymore.
+func NewRecorder(t mock.TestingT, client goovn.Client) *Client {
+	m := NewClient(t)
+	m.Fallback, m.Record = client, true
+	if err := transact.SetFunc(client, m.transact); err != nil {
+		m.fail(fmt.Sprintf("goovnmock: recording the writes of %T: %v", client, err))
+	}
+	return m
+}
+
+// Executed returns the operations of the commands of every call writing
+// commands, one entry per call
+func (m *Client) Executed() [][]libovsdb.Operation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([][]libovsdb.Operation{}, m.executed...)
+}
+
+// Operations returns the operations of every call writing commands
+func (m *Client) Operations() []libovsdb.Operation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var ops []libovsdb.Operation
+	for _, e := range m.executed {
+		ops = append(ops, e...)
+	}
+	return ops
+}
+
+// Reset forgets the operations recorded so far
+func (m *Client) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.executed = nil
+}
+
+// record records the operations of cmds
+func (m *Client) record(cmds []*goovn.OvnCommand) {
+	ops := []libovsdb.Operation{}
+	for _, cmd := range cmds {
+		if cmd != nil {
+			ops = append(ops, cmd.Operations...)
+		}
+	}
+	m.mu.Lock()
+	m.executed = append(m.executed, ops)
+	m.mu.Unlock()
+}
+
+// transact records ops instead of sending them and returns a successful
+// result for each, with a new UUID for inserts
+func (m *Client) transact(db string, ops []libovsdb.Operation) ([]libovsdb.OperationResult, error) {
+	m.mu.Lock()
+	m.executed = append(m.executed, append([]libovsdb.Operation{}, ops...))
+	m.mu.Unlock()
+	results := make([]libovsdb.OperationResult, len(ops))
+	for i, op := range ops {
+		if op.Op == "insert" {
+			results[i].UUID = libovsdb.UUID{GoUUID: uuid.New().String()}
+		}
+	}
+	return results, nil
+}
+
+// expects reports whether an expectation of method was set with On. The
+// expectations are expected to be set before the calls of the mock.
+func (m *Client) expects(method string) bool {
+	for _, c := range m.ExpectedCalls {
+		if c.Method == method {
+			return true
+		}
+	}
+	return false
+}
+
+// called returns the results of a call of method, executes is set for the
+// methods writing commands and fallback passes the call to Fallback
+func (m *Client) called(method string, args []interface{}, executes bool, fallback func() []interface{}) results {
+	if !m.expects(method) {
+		switch {
+		case executes && m.Record:
+			return nil
+		case m.Fallback != nil:
+			return fallback()
+		}
+	}
+	return results(m.MethodCalled(method, args...))
+}
+
+// noTransaction fails the test if NewTransaction has no transaction to
+// return, only a client creates a goovn.Transaction
+func (m *Client) noTransaction() {
+	m.fail("goovnmock: NewTransaction has no transaction to return, set Fallback to a client, e.g. with NewRecorder")
+}
+
+// fail reports msg to the TestingT of the mock and stops the test, or
+// panics without one
+func (m *Client) fail(msg string) {
+	if m.t == nil {
+		panic(msg)
+	}
+	m.t.Errorf("%s", msg)
+	m.t.FailNow()
+}
@@ -0,0 +1,202 @@
+/**
+ * Copyright (c) 2020 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovnmock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"testing"
+	"time"
+
+	goovn "github.com/ebay/go-ovn"
+	"github.com/ebay/go-ovn/goovntest"
+	"github.com/ebay/libovsdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// fakeT records the failures reported by a mock, FailNow ends the goroutine
+// like it ends a test
+type fakeT struct {
+	errors []string
+}
+
+func (t *fakeT) Logf(format string, args ...interface{}) {}
+
+func (t *fakeT) Errorf(format string, args ...interface{}) {
+	t.errors = append(t.errors, fmt.Sprintf(format, args...))
+}
+
+func (t *fakeT) FailNow() {
+	runtime.Goexit()
+}
+
+// failed runs f in a goroutine that ends like a test if f fails
+func failed(f func()) {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		f()
+	}()
+	<-done
+}
+
+func TestClientExpectations(t *testing.T) {
+	ft := &fakeT{}
+	m := NewClient(ft)
+	cmd := &goovn.OvnCommand{Operations: []libovsdb.Operation{{Op: "insert", Table: "Logical_Switch"}}}
+	m.On("LSAdd", "ls1").Return(cmd, nil).Once()
+	m.On("LSAdd", mock.Anything).Return(nil, errors.New("exists"))
+	m.On("LSGet", mock.MatchedBy(func(name string) bool { return name != "" })).
+		Return([]*goovn.LogicalSwitch{{Name: "ls1"}}, nil)
+	m.On("Execute", mock.Anything).Return(nil)
+
+	c, err := m.LSAdd("ls1")
+	assert.Nil(t, err)
+	assert.Equal(t, cmd, c)
+	_, err = m.LSAdd("ls1")
+	assert.EqualError(t, err, "exists")
+	lss, err := m.LSGet("ls1")
+	assert.Nil(t, err)
+	assert.Equal(t, "ls1", lss[0].Name)
+	assert.Nil(t, m.Execute(c))
+	m.AssertNumberOfCalls(t, "LSAdd", 2)
+	assert.Equal(t, [][]libovsdb.Operation{cmd.Operations}, m.Executed())
+	assert.True(t, m.AssertExpectations(ft))
+	assert.Empty(t, ft.errors)
+
+	// Calls no expectation matches fail the test
+	failed(func() { m.LSGet("") })
+	assert.Len(t, ft.errors, 1)
+	failed(func() { m.LSList() })
+	assert.Len(t, ft.errors, 2)
+
+	m.On("LSDel", "ls1").Return(nil, nil).Times(2)
+	m.LSDel("ls1")
+	assert.False(t, m.AssertExpectations(ft))
+
+	m.Reset()
+	assert.Empty(t, m.Operations())
+}
+
+func TestNewTransactionWithoutFallback(t *testing.T) {
+	ft := &fakeT{}
+	m := NewClient(ft)
+	m.On("NewTransaction").Return(nil)
+	var txn *goovn.Transaction
+	failed(func() { txn = m.NewTransaction() })
+	assert.Nil(t, txn)
+	if assert.Len(t, ft.errors, 1) {
+		assert.Contains(t, ft.errors[0], "set Fallback to a client")
+	}
+
+	// a transaction of the fallback client is returned
+	m = NewClient(t)
+	m.Fallback = goovntest.NewClient(t, goovn.DBNB)
+	assert.NotNil(t, m.NewTransaction())
+}
+
+func TestRecorder(t *testing.T) {
+	ovndbapi := goovntest.NewClient(t, goovn.DBNB)
+
+	m := NewRecorder(t, ovndbapi)
+	cmd, err := m.LSAdd("ls1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = m.Execute(cmd); err != nil {
+		t.Fatal(err)
+	}
+	ops := m.Operations()
+	if assert.Len(t, ops, 1) {
+		assert.Equal(t, "insert", ops[0].Op)
+		assert.Equal(t, "Logical_Switch", ops[0].Table)
+		assert.Equal(t, "ls1", ops[0].Row["name"])
+	}
+	// Nothing was written
+	lss, _ := ovndbapi.LSList()
+	assert.Empty(t, lss)
+}
+
+func TestRecorderWritePaths(t *testing.T) {
+	cfg := &goovn.Config{Db: goovn.DBNB}
+	m := NewRecorder(t, goovntest.NewClientWithConfig(t, cfg))
+	observer, err := goovn.NewClient(&goovn.Config{Db: goovn.DBNB, Addr: cfg.Addr})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer observer.Close()
+	ctx := context.Background()
+
+	txn := m.NewTransaction()
+	if err := txn.Add(func(c goovn.Client) (*goovn.OvnCommand, error) { return c.LSAdd("ls1") }); err != nil {
+		t.Fatal(err)
+	}
+	if err := txn.Add(func(c goovn.Client) (*goovn.OvnCommand, error) { return c.LSPAdd("ls1", "lsp1") }); err != nil {
+		t.Fatal(err)
+	}
+	uuids, err := txn.Commit()
+	assert.NoError(t, err)
+	assert.Len(t, uuids, 2)
+
+	_, err = m.Reconcile(ctx, &goovn.Topology{Owner: "test", Switches: []goovn.LogicalSwitchSpec{{Name: "ls2"}}}, "owner")
+	assert.NoError(t, err)
+
+	err = m.Import(ctx, &goovn.Snapshot{
+		Format:        goovn.SnapshotFormatVersion,
+		Database:      goovn.DBNB,
+		SchemaVersion: observer.GetSchema().Version,
+		Tables:        map[string][]goovn.SnapshotRow{goovn.TableNBGlobal: {{Name: "#1"}}},
+	}, 0)
+	assert.NoError(t, err)
+
+	executed := m.Executed()
+	if assert.Len(t, executed, 3) {
+		tables := func(ops []libovsdb.Operation) []string {
+			var tables []string
+			for _, op := range ops {
+				if op.Op == "insert" {
+					tables = append(tables, op.Table)
+				}
+			}
+			return tables
+		}
+		assert.Equal(t, []string{goovn.TableLogicalSwitch, goovn.TableLogicalSwitchPort}, tables(executed[0]))
+		assert.Equal(t, []string{goovn.TableLogicalSwitch}, tables(executed[1]))
+		assert.Equal(t, []string{goovn.TableNBGlobal}, tables(executed[2]))
+	}
+
+	// Nothing reached the db server: an insert by another client is the
+	// first change seen
+	cmd, err := observer.LSAdd("ls3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := observer.Execute(cmd); err != nil {
+		t.Fatal(err)
+	}
+	assert.Eventually(t, func() bool {
+		lss, _ := observer.LSList()
+		return len(lss) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	lss, _ := observer.LSList()
+	assert.Equal(t, "ls3", lss[0].Name)
+	_, err = observer.NBGlobalGet()
+	assert.Error(t, err)
+}
@@ -0,0 +1,70 @@
+/**
+ * Copyright (c) 2020 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovntest
+
+import (
+	"testing"
+
+	goovn "github.com/ebay/go-ovn"
+	"github.com/ebay/go-ovn/model/nb"
+	"github.com/ebay/go-ovn/model/sb"
+)
+
+// NewOVNServer creates a server of the OVN_Northbound and OVN_Southbound
+// schemas pinned in model/nb and model/sb, e.g. several of them to test
+// the failover of a cluster with Server.SetLeader
+func NewOVNServer() (*Server, error) {
+	return NewServer([]byte(nb.Schema), []byte(sb.Schema))
+}
+
+// StartServer starts a server of the OVN_Northbound and OVN_Southbound
+// schemas pinned in model/nb and model/sb and returns its address. The
+// server is closed when the test completes.
+func StartServer(t testing.TB) string {
+	t.Helper()
+	srv, err := NewOVNServer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { srv.Close() })
+	addr, err := srv.Listen()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return addr
+}
+
+// NewClient returns a client of db, goovn.DBNB or goovn.DBSB, of a new
+// server started with StartServer. The client is closed when the test
+// completes.
+func NewClient(t testing.TB, db string) goovn.Client {
+	t.Helper()
+	return NewClientWithConfig(t, &goovn.Config{Db: db})
+}
+
+// NewClientWithConfig is NewClient with the settings of cfg, e.g. a
+// SignalCB, its Addr is set to the address of the new server.
+func NewClientWithConfig(t testing.TB, cfg *goovn.Config) goovn.Client {
+	t.Helper()
+	cfg.Addr = StartServer(t)
+	c, err := goovn.NewClient(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { c.Close() })
+	return c
+}
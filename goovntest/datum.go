@@ -0,0 +1,390 @@
+/**
+ * Copyright (c) 2020 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovntest
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+// uuidAtom is the value of an atom of type uuid
+type uuidAtom string
+
+// datum is the value of a column: a sorted set of atoms, or for maps a sorted
+// set of keys with their values
+type datum struct {
+	keys   []interface{}
+	values []interface{}
+}
+
+// ovsdbError is an RFC 7047 <error>
+type ovsdbError struct {
+	Kind    string `json:"error"`
+	Details string `json:"details,omitempty"`
+}
+
+func (e *ovsdbError) Error() string {
+	return e.Kind + ": " + e.Details
+}
+
+func newError(kind, format string, args ...interface{}) *ovsdbError {
+	return &ovsdbError{Kind: kind, Details: fmt.Sprintf(format, args...)}
+}
+
+func syntaxError(format string, args ...interface{}) *ovsdbError {
+	return newError("syntax error", format, args...)
+}
+
+func constraintViolation(format string, args ...interface{}) *ovsdbError {
+	return newError("constraint violation", format, args...)
+}
+
+func compareAtoms(a, b interface{}) int {
+	switch a := a.(type) {
+	case int64:
+		b := b.(int64)
+		switch {
+		case a < b:
+			return -1
+		case a > b:
+			return 1
+		}
+		return 0
+	case float64:
+		b := b.(float64)
+		switch {
+		case a < b:
+			return -1
+		case a > b:
+			return 1
+		}
+		return 0
+	case bool:
+		b := b.(bool)
+		switch {
+		case a == b:
+			return 0
+		case !a:
+			return -1
+		}
+		return 1
+	case string:
+		return strings.Compare(a, b.(string))
+	case uuidAtom:
+		return strings.Compare(string(a), string(b.(uuidAtom)))
+	}
+	panic(fmt.Sprintf("unexpected atom %T", a))
+}
+
+// parseAtom parses the json of an atom of type b, named-uuids are resolved
+// with named
+func parseAtom(b *baseType, v interface{}, named map[string]string) (interface{}, error) {
+	var atom interface{}
+	switch b.Type {
+	case "integer":
+		switch n := v.(type) {
+		case json.Number:
+			i, err := n.Int64()
+			if err != nil {
+				return nil, syntaxError("%s is not an integer", n)
+			}
+			atom = i
+		case float64:
+			if n != math.Trunc(n) {
+				return nil, syntaxError("%v is not an integer", n)
+			}
+			atom = int64(n)
+		default:
+			return nil, syntaxError("expected integer, got %v", v)
+		}
+	case "real":
+		switch n := v.(type) {
+		case json.Number:
+			f, err := n.Float64()
+			if err != nil {
+				return nil, syntaxError("%s is not a real", n)
+			}
+			atom = f
+		case float64:
+			atom = n
+		default:
+			return nil, syntaxError("expected real, got %v", v)
+		}
+	case "boolean":
+		bv, ok := v.(bool)
+		if !ok {
+			return nil, syntaxError("expected boolean, got %v", v)
+		}
+		atom = bv
+	case "string":
+		s, ok := v.(string)
+		if !ok {
+			return nil, syntaxError("expected string, got %v", v)
+		}
+		atom = s
+	case "uuid":
+		pair, ok := v.([]interface{})
+		if !ok || len(pair) != 2 {
+			return nil, syntaxError("expected uuid, got %v", v)
+		}
+		kind, _ := pair[0].(string)
+		id, ok := pair[1].(string)
+		if !ok {
+			return nil, syntaxError("expected uuid, got %v", v)
+		}
+		switch kind {
+		case "uuid":
+			atom = uuidAtom(id)
+		case "named-uuid":
+			uuid, ok := named[id]
+			if !ok {
+				return nil, syntaxError("named-uuid %s is not defined", id)
+			}
+			atom = uuidAtom(uuid)
+		default:
+			return nil, syntaxError("expected uuid, got %v", v)
+		}
+	}
+	if err := checkAtom(b, atom); err != nil {
+		return nil, err
+	}
+	return atom, nil
+}
+
+// checkAtom checks atom against the enum and the range of b
+func checkAtom(b *baseType, atom interface{}) error {
+	if len(b.Enum) > 0 {
+		found := false
+		for _, e := range b.Enum {
+			if compareAtoms(e, atom) == 0 {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return constraintViolation("%v is not one of the allowed values", atom)
+		}
+	}
+	if i, ok := atom.(int64); ok {
+		if (b.MinInteger != nil && i < *b.MinInteger) || (b.MaxInteger != nil && i > *b.MaxInteger) {
+			return constraintViolation("%d is out of the allowed range", i)
+		}
+	}
+	return nil
+}
+
+// parseDatum parses the json of a value of type t, the number of elements is
+// not checked against the min and max of t
+func parseDatum(t *columnType, v interface{}, named map[string]string) (datum, error) {
+	var d datum
+	arr, _ := v.([]interface{})
+	if t.isMap() {
+		if len(arr) != 2 || arr[0] != "map" {
+			return d, syntaxError("expected map, got %v", v)
+		}
+		pairs, ok := arr[1].([]interface{})
+		if !ok {
+			return d, syntaxError("expected map, got %v", v)
+		}
+		for _, p := range pairs {
+			pair, ok := p.([]interface{})
+			if !ok || len(pair) != 2 {
+				return d, syntaxError("expected map pair, got %v", p)
+			}
+			key, err := parseAtom(&t.Key, pair[0], named)
+			if err != nil {
+				return d, err
+			}
+			value, err := parseAtom(t.Value, pair[1], named)
+			if err != nil {
+				return d, err
+			}
+			d.keys = append(d.keys, key)
+			d.values = append(d.values, value)
+		}
+		if err := d.sort(); err != nil {
+			return d, constraintViolation("map contains duplicate key")
+		}
+		return d, nil
+	}
+	elems := []interface{}{v}
+	if len(arr) == 2 && arr[0] == "set" {
+		if elems, ok := arr[1].([]interface{}); ok {
+			for _, e := range elems {
+				atom, err := parseAtom(&t.Key, e, named)
+				if err != nil {
+					return d, err
+				}
+				d.keys = append(d.keys, atom)
+			}
+			if err := d.sort(); err != nil {
+				return d, constraintViolation("set contains duplicate value")
+			}
+			return d, nil
+		}
+	}
+	for _, e := range elems {
+		atom, err := parseAtom(&t.Key, e, named)
+		if err != nil {
+			return d, err
+		}
+		d.keys = append(d.keys, atom)
+	}
+	return d, nil
+}
+
+type datumSorter datum
+
+func (s *datumSorter) Len() int { return len(s.keys) }
+func (s *datumSorter) Less(i, j int) bool {
+	return compareAtoms(s.keys[i], s.keys[j]) < 0
+}
+func (s *datumSorter) Swap(i, j int) {
+	s.keys[i], s.keys[j] = s.keys[j], s.keys[i]
+	if s.values != nil {
+		s.values[i], s.values[j] = s.values[j], s.values[i]
+	}
+}
+
+// sort sorts the keys of d, it fails if there are duplicate keys
+func (d *datum) sort() error {
+	sort.Sort((*datumSorter)(d))
+	for i := 1; i < len(d.keys); i++ {
+		if compareAtoms(d.keys[i-1], d.keys[i]) == 0 {
+			return fmt.Errorf("duplicate key %v", d.keys[i])
+		}
+	}
+	return nil
+}
+
+// find returns the index of key in d or -1
+func (d datum) find(key interface{}) int {
+	i := sort.Search(len(d.keys), func(i int) bool {
+		return compareAtoms(d.keys[i], key) >= 0
+	})
+	if i < len(d.keys) && compareAtoms(d.keys[i], key) == 0 {
+		return i
+	}
+	return -1
+}
+
+func (d datum) equal(o datum) bool {
+	if len(d.keys) != len(o.keys) {
+		return false
+	}
+	for i := range d.keys {
+		if compareAtoms(d.keys[i], o.keys[i]) != 0 {
+			return false
+		}
+		if d.values != nil && compareAtoms(d.values[i], o.values[i]) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// includes reports whether every element of o is in d
+func (d datum) includes(o datum) bool {
+	for i, key := range o.keys {
+		j := d.find(key)
+		if j < 0 {
+			return false
+		}
+		if o.values != nil && compareAtoms(d.values[j], o.values[i]) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// clone returns a copy of d that can be modified
+func (d datum) clone() datum {
+	c := datum{keys: append([]interface{}{}, d.keys...)}
+	if d.values != nil {
+		c.values = append([]interface{}{}, d.values...)
+	}
+	return c
+}
+
+// checkSize checks the number of elements of d against the min and max of t
+func (d datum) checkSize(t *columnType) error {
+	n := len(d.keys)
+	if n < t.Min || (t.Max != unlimited && n > t.Max) {
+		return constraintViolation("%d values where the column allows between %d and %d", n, t.Min, t.Max)
+	}
+	return nil
+}
+
+func defaultAtom(b *baseType) interface{} {
+	switch b.Type {
+	case "integer":
+		return int64(0)
+	case "real":
+		return float64(0)
+	case "boolean":
+		return false
+	case "uuid":
+		return uuidAtom("00000000-0000-0000-0000-000000000000")
+	}
+	return ""
+}
+
+// defaultDatum is the value of a column of type t of a new row
+func defaultDatum(t *columnType) datum {
+	var d datum
+	if t.Min == 0 {
+		if t.isMap() {
+			d.values = []interface{}{}
+		}
+		return d
+	}
+	d.keys = []interface{}{defaultAtom(&t.Key)}
+	if t.isMap() {
+		d.values = []interface{}{defaultAtom(t.Value)}
+	}
+	return d
+}
+
+func encodeAtom(atom interface{}) interface{} {
+	if uuid, ok := atom.(uuidAtom); ok {
+		return []interface{}{"uuid", string(uuid)}
+	}
+	return atom
+}
+
+// encodeDatum returns the json of d, like ovsdb-server sets of one element
+// are encoded as the element
+func encodeDatum(t *columnType, d datum) interface{} {
+	if t.isMap() {
+		pairs := make([]interface{}, 0, len(d.keys))
+		for i := range d.keys {
+			pairs = append(pairs, []interface{}{encodeAtom(d.keys[i]), encodeAtom(d.values[i])})
+		}
+		return []interface{}{"map", pairs}
+	}
+	if len(d.keys) == 1 {
+		return encodeAtom(d.keys[0])
+	}
+	elems := make([]interface{}, 0, len(d.keys))
+	for _, key := range d.keys {
+		elems = append(elems, encodeAtom(key))
+	}
+	return []interface{}{"set", elems}
+}
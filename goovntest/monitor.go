@@ -0,0 +1,166 @@
+/**
+ * Copyright (c) 2020 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovntest
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// monitorSelect is an RFC 7047 <monitor-select>, every member defaults to true
+type monitorSelect struct {
+	Initial *bool `json:"initial"`
+	Insert  *bool `json:"insert"`
+	Delete  *bool `json:"delete"`
+	Modify  *bool `json:"modify"`
+}
+
+type monitorRequest struct {
+	Columns []string       `json:"columns"`
+	Select  *monitorSelect `json:"select"`
+}
+
+// tableMonitor is what a monitor selects of a table
+type tableMonitor struct {
+	schema                          *tableSchema
+	columns                         []string
+	initial, insert, delete, modify bool
+}
+
+// monitor is a monitor created by a client
+type monitor struct {
+	db     *database
+	value  interface{}
+	tables map[string]*tableMonitor
+}
+
+func isTrue(b *bool) bool {
+	return b == nil || *b
+}
+
+// newMonitor parses the <monitor-requests> of a monitor of db
+func newMonitor(db *database, value interface{}, raw json.RawMessage) (*monitor, error) {
+	var requests map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &requests); err != nil {
+		return nil, fmt.Errorf("invalid monitor requests: %v", err)
+	}
+	m := &monitor{db: db, value: value, tables: make(map[string]*tableMonitor)}
+	for table, data := range requests {
+		ts, ok := db.schema.Tables[table]
+		if !ok {
+			return nil, fmt.Errorf("unknown table %s", table)
+		}
+		// A table has one request or an array of requests
+		var reqs []monitorRequest
+		if err := json.Unmarshal(data, &reqs); err != nil {
+			var req monitorRequest
+			if err := json.Unmarshal(data, &req); err != nil {
+				return nil, fmt.Errorf("invalid monitor request for table %s: %v", table, err)
+			}
+			reqs = []monitorRequest{req}
+		}
+		tm := &tableMonitor{schema: ts}
+		seen := make(map[string]bool)
+		for _, req := range reqs {
+			columns := req.Columns
+			if len(columns) == 0 {
+				columns = ts.names
+			}
+			for _, column := range columns {
+				if _, ok := ts.Columns[column]; !ok {
+					return nil, fmt.Errorf("unknown column %s in table %s", column, table)
+				}
+				if !seen[column] {
+					seen[column] = true
+					tm.columns = append(tm.columns, column)
+				}
+			}
+			sel := req.Select
+			if sel == nil {
+				sel = &monitorSelect{}
+			}
+			tm.initial = tm.initial || isTrue(sel.Initial)
+			tm.insert = tm.insert || isTrue(sel.Insert)
+			tm.delete = tm.delete || isTrue(sel.Delete)
+			tm.modify = tm.modify || isTrue(sel.Modify)
+		}
+		m.tables[table] = tm
+	}
+	return m, nil
+}
+
+// initial returns the <table-updates> of the current rows of the database
+func (m *monitor) initial() map[string]map[string]interface{} {
+	updates := make(map[string]map[string]interface{})
+	for table, tm := range m.tables {
+		if !tm.initial || len(m.db.tables[table]) == 0 {
+			continue
+		}
+		rows := make(map[string]interface{})
+		for id, r := range m.db.tables[table] {
+			rows[id] = map[string]interface{}{"new": encodeRow(tm.schema, r, tm.columns)}
+		}
+		updates[table] = rows
+	}
+	return updates
+}
+
+// updates returns the <table-updates> of the changes of a transaction, nil
+// if the monitor does not select any of them
+func (m *monitor) updates(changes map[string]map[string]rowChange) map[string]map[string]interface{} {
+	var updates map[string]map[string]interface{}
+	for table, tableChanges := range changes {
+		tm, ok := m.tables[table]
+		if !ok {
+			continue
+		}
+		rows := make(map[string]interface{})
+		for id, change := range tableChanges {
+			switch {
+			case change.old == nil:
+				if tm.insert {
+					rows[id] = map[string]interface{}{"new": encodeRow(tm.schema, change.new, tm.columns)}
+				}
+			case change.new == nil:
+				if tm.delete {
+					rows[id] = map[string]interface{}{"old": encodeRow(tm.schema, change.old, tm.columns)}
+				}
+			case tm.modify:
+				// old only has the monitored columns that changed
+				var changed []string
+				for _, column := range tm.columns {
+					if !change.old[column].equal(change.new[column]) {
+						changed = append(changed, column)
+					}
+				}
+				if len(changed) > 0 {
+					rows[id] = map[string]interface{}{
+						"old": encodeRow(tm.schema, change.old, changed),
+						"new": encodeRow(tm.schema, change.new, tm.columns),
+					}
+				}
+			}
+		}
+		if len(rows) > 0 {
+			if updates == nil {
+				updates = make(map[string]map[string]interface{})
+			}
+			updates[table] = rows
+		}
+	}
+	return updates
+}
@@ -0,0 +1,214 @@
+/**
+ * Copyright (c) 2020 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovntest
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// unlimited is the max of a column type whose max is "unlimited"
+const unlimited = -1
+
+// baseType is an RFC 7047 <base-type>
+type baseType struct {
+	Type       string
+	Enum       []interface{}
+	MinInteger *int64
+	MaxInteger *int64
+	RefTable   string
+	RefType    string
+}
+
+func (b *baseType) UnmarshalJSON(data []byte) error {
+	var atomic string
+	if err := json.Unmarshal(data, &atomic); err == nil {
+		b.Type = atomic
+		return b.check()
+	}
+	var obj struct {
+		Type       string      `json:"type"`
+		Enum       interface{} `json:"enum"`
+		MinInteger *int64      `json:"minInteger"`
+		MaxInteger *int64      `json:"maxInteger"`
+		RefTable   string      `json:"refTable"`
+		RefType    string      `json:"refType"`
+	}
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return err
+	}
+	b.Type = obj.Type
+	b.MinInteger = obj.MinInteger
+	b.MaxInteger = obj.MaxInteger
+	b.RefTable = obj.RefTable
+	b.RefType = obj.RefType
+	if b.RefTable != "" && b.RefType == "" {
+		b.RefType = "strong"
+	}
+	if err := b.check(); err != nil {
+		return err
+	}
+	if obj.Enum != nil {
+		enum, err := parseDatum(&columnType{Key: *b, Min: 0, Max: unlimited}, obj.Enum, nil)
+		if err != nil {
+			return fmt.Errorf("invalid enum: %v", err)
+		}
+		b.Enum = enum.keys
+	}
+	return nil
+}
+
+func (b *baseType) check() error {
+	switch b.Type {
+	case "integer", "real", "boolean", "string", "uuid":
+		return nil
+	}
+	return fmt.Errorf("unknown atomic type %q", b.Type)
+}
+
+// columnType is an RFC 7047 <type>
+type columnType struct {
+	Key   baseType
+	Value *baseType
+	Min   int
+	Max   int
+}
+
+func (t *columnType) UnmarshalJSON(data []byte) error {
+	var atomic string
+	if err := json.Unmarshal(data, &atomic); err == nil {
+		t.Key = baseType{Type: atomic}
+		t.Min, t.Max = 1, 1
+		return t.Key.check()
+	}
+	var obj struct {
+		Key   baseType    `json:"key"`
+		Value *baseType   `json:"value"`
+		Min   *int        `json:"min"`
+		Max   interface{} `json:"max"`
+	}
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return err
+	}
+	t.Key, t.Value = obj.Key, obj.Value
+	t.Min, t.Max = 1, 1
+	if obj.Min != nil {
+		t.Min = *obj.Min
+	}
+	switch max := obj.Max.(type) {
+	case nil:
+	case float64:
+		t.Max = int(max)
+	case string:
+		if max != "unlimited" {
+			return fmt.Errorf("invalid max %q", max)
+		}
+		t.Max = unlimited
+	default:
+		return fmt.Errorf("invalid max %v", max)
+	}
+	return nil
+}
+
+func (t *columnType) isMap() bool {
+	return t.Value != nil
+}
+
+// isScalar reports whether values of the type are a single atom
+func (t *columnType) isScalar() bool {
+	return t.Min == 1 && t.Max == 1 && !t.isMap()
+}
+
+type columnSchema struct {
+	Type      columnType `json:"type"`
+	Ephemeral bool       `json:"ephemeral"`
+	Mutable   *bool      `json:"mutable"`
+}
+
+func (c *columnSchema) mutable() bool {
+	return c.Mutable == nil || *c.Mutable
+}
+
+type tableSchema struct {
+	Columns map[string]*columnSchema `json:"columns"`
+	MaxRows int                      `json:"maxRows"`
+	IsRoot  *bool                    `json:"isRoot"`
+	Indexes [][]string               `json:"indexes"`
+	root    bool
+	// names lists the user columns in a stable order
+	names []string
+}
+
+// uuidColumn is the type of the _uuid and _version columns
+var uuidColumn = &columnSchema{Type: columnType{Key: baseType{Type: "uuid"}, Min: 1, Max: 1}, Mutable: new(bool)}
+
+// column returns the schema of a user column or of _uuid and _version
+func (t *tableSchema) column(name string) *columnSchema {
+	if name == "_uuid" || name == "_version" {
+		return uuidColumn
+	}
+	return t.Columns[name]
+}
+
+type dbSchema struct {
+	Name    string                  `json:"name"`
+	Version string                  `json:"version"`
+	Tables  map[string]*tableSchema `json:"tables"`
+}
+
+// parseSchema parses and checks an RFC 7047 <database-schema>
+func parseSchema(data []byte) (*dbSchema, error) {
+	var s dbSchema
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, err
+	}
+	if s.Name == "" || len(s.Tables) == 0 {
+		return nil, fmt.Errorf("not a database schema")
+	}
+	// For compatibility with old schemas every table is a root table if
+	// no table specifies isRoot
+	anyRoot := false
+	for _, table := range s.Tables {
+		if table.IsRoot != nil {
+			anyRoot = true
+		}
+	}
+	for name, table := range s.Tables {
+		table.root = !anyRoot || (table.IsRoot != nil && *table.IsRoot)
+		for column, col := range table.Columns {
+			table.names = append(table.names, column)
+			for _, b := range []*baseType{&col.Type.Key, col.Type.Value} {
+				if b == nil || b.RefTable == "" {
+					continue
+				}
+				if _, ok := s.Tables[b.RefTable]; !ok {
+					return nil, fmt.Errorf("column %s of table %s refers to unknown table %s", column, name, b.RefTable)
+				}
+			}
+		}
+		sort.Strings(table.names)
+		for _, index := range table.Indexes {
+			for _, column := range index {
+				if _, ok := table.Columns[column]; !ok {
+					return nil, fmt.Errorf("index of table %s has unknown column %s", name, column)
+				}
+			}
+		}
+	}
+	return &s, nil
+}
@@ -0,0 +1,491 @@
+/**
+ * Copyright (c) 2020 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+// Package goovntest provides an in-process OVSDB server for hermetic tests of
+// code using goovn.
+//
+// The server loads database schemas such as the ovn-nb.ovsschema and
+// ovn-sb.ovsschema of OVN, keeps the databases in memory and implements the
+// list_dbs, get_schema, transact, monitor, monitor_cancel and echo methods of
+// RFC 7047. Transactions support the insert, select, update, mutate, delete,
+// wait, commit, abort and comment operations, and like ovsdb-server enforce
+// referential integrity, remove weak references to deleted rows and garbage
+// collect the rows of non-root tables that are no longer referred to. Locks
+// are not supported.
+//
+// Like ovsdb-server the server also serves the _Server database, in which
+// every database is standalone and led by the server until SetLeader makes
+// it a clustered one, e.g. to test the failover of Config.LeaderOnly.
+//
+// A test typically gets a client of a new server of the OVN schemas pinned
+// in model/nb and model/sb, both are closed when the test completes:
+//
+//	ovndbapi := goovntest.NewClient(t, goovn.DBNB)
+//
+// Other schemas are served by a server created with NewServer or
+// NewServerFromFiles:
+//
+//	srv, err := goovntest.NewServerFromFiles("ovn-nb.ovsschema")
+//	...
+//	defer srv.Close()
+//	addr, err := srv.Listen()
+//	...
+//	ovndbapi, err := goovn.NewClient(&goovn.Config{Db: goovn.DBNB, Addr: addr})
+package goovntest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Server is an in-process OVSDB server
+type Server struct {
+	mu        sync.Mutex
+	dbs       map[string]*database
+	conns     map[*conn]bool
+	listeners []net.Listener
+	dirs      []string
+	// changed is closed and replaced by every commit to wake up the
+	// transactions waiting for a wait operation
+	changed chan struct{}
+	done    chan struct{}
+	closed  bool
+}
+
+// NewServer creates a server serving a database for each schema and the
+// _Server database
+func NewServer(schemas ...[]byte) (*Server, error) {
+	s := &Server{
+		dbs:     make(map[string]*database),
+		conns:   make(map[*conn]bool),
+		changed: make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+	for _, schema := range schemas {
+		db, err := newDatabase(schema)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := s.dbs[db.schema.Name]; ok {
+			return nil, fmt.Errorf("duplicate database %s", db.schema.Name)
+		}
+		s.dbs[db.schema.Name] = db
+	}
+	if _, ok := s.dbs[serverDatabase]; !ok {
+		if err := s.addServerDatabase(); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// NewServerFromFiles creates a server serving a database for each schema file
+func NewServerFromFiles(paths ...string) (*Server, error) {
+	var schemas [][]byte
+	for _, path := range paths {
+		schema, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		schemas = append(schemas, schema)
+	}
+	return NewServer(schemas...)
+}
+
+// Listen serves clients on a unix socket in a new temporary directory and
+// returns its address in the format of Config.Addr, e.g. "unix:/tmp/goovntest123/db.sock"
+func (s *Server) Listen() (string, error) {
+	dir, err := ioutil.TempDir("", "goovntest")
+	if err != nil {
+		return "", err
+	}
+	path := filepath.Join(dir, "db.sock")
+	l, err := net.Listen("unix", path)
+	if err != nil {
+		os.RemoveAll(dir)
+		return "", err
+	}
+	s.mu.Lock()
+	s.dirs = append(s.dirs, dir)
+	s.mu.Unlock()
+	go s.Serve(l)
+	return "unix:" + path, nil
+}
+
+// Serve serves the clients accepted by l until the server is closed
+func (s *Server) Serve(l net.Listener) error {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		l.Close()
+		return errors.New("server closed")
+	}
+	s.listeners = append(s.listeners, l)
+	s.mu.Unlock()
+	for {
+		c, err := l.Accept()
+		if err != nil {
+			select {
+			case <-s.done:
+				return nil
+			default:
+				return err
+			}
+		}
+		go s.ServeConn(c)
+	}
+}
+
+// ServeConn serves a single client, e.g. one end of a net.Pipe, until the
+// client or the server closes the connection
+func (s *Server) ServeConn(rwc io.ReadWriteCloser) {
+	c := newConn(rwc)
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		rwc.Close()
+		return
+	}
+	s.conns[c] = true
+	s.mu.Unlock()
+	go c.writeLoop()
+	defer func() {
+		s.mu.Lock()
+		delete(s.conns, c)
+		s.mu.Unlock()
+		c.close()
+	}()
+
+	dec := json.NewDecoder(rwc)
+	for {
+		var req struct {
+			Method string          `json:"method"`
+			Params json.RawMessage `json:"params"`
+			ID     json.RawMessage `json:"id"`
+		}
+		if err := dec.Decode(&req); err != nil {
+			return
+		}
+		if req.Method == "" {
+			// A reply, the server does not send requests expecting one
+			continue
+		}
+		result, err := s.handle(c, req.Method, req.Params)
+		if len(req.ID) == 0 || bytes.Equal(req.ID, []byte("null")) {
+			continue
+		}
+		resp := response{ID: req.ID, Result: result}
+		if err != nil {
+			// The error is a string rather than an object like
+			// ovsdb-server sends, libovsdb only understands strings
+			resp.Result, resp.Error = nil, err.Error()
+		}
+		c.send(resp)
+	}
+}
+
+// Close disconnects the clients and stops serving
+func (s *Server) Close() error {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return nil
+	}
+	s.closed = true
+	close(s.done)
+	listeners, dirs := s.listeners, s.dirs
+	conns := make([]*conn, 0, len(s.conns))
+	for c := range s.conns {
+		conns = append(conns, c)
+	}
+	s.mu.Unlock()
+	for _, l := range listeners {
+		l.Close()
+	}
+	for _, c := range conns {
+		c.close()
+	}
+	for _, dir := range dirs {
+		os.RemoveAll(dir)
+	}
+	return nil
+}
+
+type response struct {
+	ID     json.RawMessage `json:"id"`
+	Result interface{}     `json:"result"`
+	Error  interface{}     `json:"error"`
+}
+
+type notification struct {
+	ID     interface{}   `json:"id"`
+	Method string        `json:"method"`
+	Params []interface{} `json:"params"`
+}
+
+// decodeParams decodes the params of a request, numbers are kept as json.Number
+func decodeParams(raw json.RawMessage) ([]json.RawMessage, error) {
+	var params []json.RawMessage
+	if len(raw) == 0 || bytes.Equal(raw, []byte("null")) {
+		return nil, nil
+	}
+	if err := json.Unmarshal(raw, &params); err != nil {
+		return nil, fmt.Errorf("params is not an array: %v", err)
+	}
+	return params, nil
+}
+
+func decodeValue(raw json.RawMessage) (interface{}, error) {
+	var v interface{}
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	err := dec.Decode(&v)
+	return v, err
+}
+
+func (s *Server) database(raw json.RawMessage) (*database, error) {
+	var name string
+	if err := json.Unmarshal(raw, &name); err != nil {
+		return nil, fmt.Errorf("database name is not a string")
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	db, ok := s.dbs[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown database %s", name)
+	}
+	return db, nil
+}
+
+func (s *Server) handle(c *conn, method string, raw json.RawMessage) (interface{}, error) {
+	params, err := decodeParams(raw)
+	if err != nil {
+		return nil, err
+	}
+	switch method {
+	case "echo":
+		if params == nil {
+			params = []json.RawMessage{}
+		}
+		return params, nil
+	case "list_dbs":
+		s.mu.Lock()
+		names := make([]string, 0, len(s.dbs))
+		for name := range s.dbs {
+			names = append(names, name)
+		}
+		s.mu.Unlock()
+		sort.Strings(names)
+		return names, nil
+	case "get_schema":
+		if len(params) != 1 {
+			return nil, errors.New("get_schema expects a database name")
+		}
+		db, err := s.database(params[0])
+		if err != nil {
+			return nil, err
+		}
+		return json.RawMessage(db.raw), nil
+	case "transact":
+		if len(params) < 1 {
+			return nil, errors.New("transact expects a database name")
+		}
+		db, err := s.database(params[0])
+		if err != nil {
+			return nil, err
+		}
+		ops := make([]interface{}, 0, len(params)-1)
+		for _, p := range params[1:] {
+			op, err := decodeValue(p)
+			if err != nil {
+				return nil, err
+			}
+			ops = append(ops, op)
+		}
+		return s.transact(db, ops)
+	case "monitor":
+		if len(params) != 3 {
+			return nil, errors.New("monitor expects a database name, a json-value and monitor requests")
+		}
+		db, err := s.database(params[0])
+		if err != nil {
+			return nil, err
+		}
+		value, err := decodeValue(params[1])
+		if err != nil {
+			return nil, err
+		}
+		m, err := newMonitor(db, value, params[2])
+		if err != nil {
+			return nil, err
+		}
+		return s.monitor(c, m)
+	case "monitor_cancel":
+		if len(params) != 1 {
+			return nil, errors.New("monitor_cancel expects a json-value")
+		}
+		value, err := decodeValue(params[0])
+		if err != nil {
+			return nil, err
+		}
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		key := monitorKey(value)
+		if _, ok := c.monitors[key]; !ok {
+			return nil, errors.New("unknown monitor")
+		}
+		delete(c.monitors, key)
+		return map[string]interface{}{}, nil
+	}
+	return nil, fmt.Errorf("unknown method %s", method)
+}
+
+func monitorKey(value interface{}) string {
+	b, _ := json.Marshal(value)
+	return string(b)
+}
+
+func (s *Server) monitor(c *conn, m *monitor) (interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := monitorKey(m.value)
+	if _, ok := c.monitors[key]; ok {
+		return nil, errors.New("duplicate monitor ID")
+	}
+	c.monitors[key] = m
+	return m.initial(), nil
+}
+
+// transact executes a transaction, if one of its wait operations is not
+// satisfied it is retried after every commit until its timeout expires
+func (s *Server) transact(db *database, ops []interface{}) (interface{}, error) {
+	var deadline time.Time
+	for {
+		s.mu.Lock()
+		if s.closed {
+			s.mu.Unlock()
+			return nil, errors.New("server closed")
+		}
+		final := !deadline.IsZero() && !time.Now().Before(deadline)
+		results, changes, blocked := db.transact(ops, final)
+		if !blocked {
+			if len(changes) > 0 {
+				s.notify(db, changes)
+				close(s.changed)
+				s.changed = make(chan struct{})
+			}
+			s.mu.Unlock()
+			return results, nil
+		}
+		if deadline.IsZero() {
+			deadline = time.Now().Add(waitTimeout(ops))
+		}
+		changed := s.changed
+		s.mu.Unlock()
+		timer := time.NewTimer(time.Until(deadline))
+		select {
+		case <-changed:
+		case <-timer.C:
+		case <-s.done:
+		}
+		timer.Stop()
+	}
+}
+
+// notify sends the changes of a transaction to the monitors of db, the
+// server lock is held so that clients get the updates in commit order
+func (s *Server) notify(db *database, changes map[string]map[string]rowChange) {
+	for c := range s.conns {
+		for _, m := range c.monitors {
+			if m.db != db {
+				continue
+			}
+			if updates := m.updates(changes); updates != nil {
+				c.send(notification{Method: "update", Params: []interface{}{m.value, updates}})
+			}
+		}
+	}
+}
+
+// conn is a client connection, messages are queued so that the server never
+// blocks on a client that does not read
+type conn struct {
+	rwc      io.ReadWriteCloser
+	monitors map[string]*monitor // guarded by the server lock
+
+	mu     sync.Mutex
+	cond   *sync.Cond
+	queue  []interface{}
+	closed bool
+}
+
+func newConn(rwc io.ReadWriteCloser) *conn {
+	c := &conn{rwc: rwc, monitors: make(map[string]*monitor)}
+	c.cond = sync.NewCond(&c.mu)
+	return c
+}
+
+func (c *conn) send(msg interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.closed {
+		c.queue = append(c.queue, msg)
+		c.cond.Signal()
+	}
+}
+
+func (c *conn) writeLoop() {
+	enc := json.NewEncoder(c.rwc)
+	for {
+		c.mu.Lock()
+		for len(c.queue) == 0 && !c.closed {
+			c.cond.Wait()
+		}
+		if c.closed {
+			c.mu.Unlock()
+			return
+		}
+		queue := c.queue
+		c.queue = nil
+		c.mu.Unlock()
+		for _, msg := range queue {
+			if err := enc.Encode(msg); err != nil {
+				c.close()
+				return
+			}
+		}
+	}
+}
+
+func (c *conn) close() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.closed {
+		c.closed = true
+		c.rwc.Close()
+		c.cond.Signal()
+	}
+}
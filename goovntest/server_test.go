@@ -0,0 +1,342 @@
+/**
+ * Copyright (c) 2020 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovntest
+
+import (
+	"encoding/json"
+	"net"
+	"testing"
+	"time"
+
+	goovn "github.com/ebay/go-ovn"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestServer(t *testing.T) *Server {
+	srv, err := NewOVNServer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return srv
+}
+
+func newTestClient(t *testing.T, srv *Server, db string) goovn.Client {
+	addr, err := srv.Listen()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ovndbapi, err := goovn.NewClient(&goovn.Config{Db: db, Addr: addr})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ovndbapi
+}
+
+// rpcClient speaks raw JSON-RPC to a server over a net.Pipe
+type rpcClient struct {
+	t   *testing.T
+	enc *json.Encoder
+	dec *json.Decoder
+	id  int
+	// updates are the params of the update notifications received
+	updates [][]interface{}
+}
+
+func newRPCClient(t *testing.T, srv *Server) *rpcClient {
+	client, server := net.Pipe()
+	go srv.ServeConn(server)
+	dec := json.NewDecoder(client)
+	dec.UseNumber()
+	return &rpcClient{t: t, enc: json.NewEncoder(client), dec: dec}
+}
+
+func (c *rpcClient) call(method string, params ...interface{}) (interface{}, interface{}) {
+	c.id++
+	if err := c.enc.Encode(map[string]interface{}{"id": c.id, "method": method, "params": params}); err != nil {
+		c.t.Fatal(err)
+	}
+	for {
+		var msg map[string]interface{}
+		if err := c.dec.Decode(&msg); err != nil {
+			c.t.Fatal(err)
+		}
+		if msg["method"] == "update" {
+			c.updates = append(c.updates, msg["params"].([]interface{}))
+			continue
+		}
+		return msg["result"], msg["error"]
+	}
+}
+
+// transact returns the results of the operations of a transaction
+func (c *rpcClient) transact(ops ...map[string]interface{}) []interface{} {
+	params := []interface{}{"OVN_Northbound"}
+	for _, op := range ops {
+		params = append(params, op)
+	}
+	result, err := c.call("transact", params...)
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	return result.([]interface{})
+}
+
+func resultError(result interface{}) string {
+	if m, ok := result.(map[string]interface{}); ok {
+		if e, ok := m["error"].(string); ok {
+			return e
+		}
+	}
+	return ""
+}
+
+func TestServerClient(t *testing.T) {
+	srv := newTestServer(t)
+	defer srv.Close()
+	ovndbapi := newTestClient(t, srv, goovn.DBNB)
+	defer ovndbapi.Close()
+
+	var cmds []*goovn.OvnCommand
+	cmd, err := ovndbapi.LSAdd("ls1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmds = append(cmds, cmd)
+	cmd, err = ovndbapi.LSPAdd("ls1", "lsp1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmds = append(cmds, cmd)
+	if err = ovndbapi.Execute(cmds...); err != nil {
+		t.Fatal(err)
+	}
+	cmd, err = ovndbapi.LSPSetAddress("lsp1", "00:00:00:00:00:01 10.0.0.1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = ovndbapi.Execute(cmd); err != nil {
+		t.Fatal(err)
+	}
+
+	lsps, err := ovndbapi.LSPList("ls1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if assert.Len(t, lsps, 1) {
+		assert.Equal(t, "lsp1", lsps[0].Name)
+		assert.Equal(t, []string{"00:00:00:00:00:01 10.0.0.1"}, lsps[0].Addresses)
+	}
+
+	// A second client sees the changes through its monitor
+	other := newTestClient(t, srv, goovn.DBNB)
+	defer other.Close()
+	lss, err := other.LSList()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if assert.Len(t, lss, 1) {
+		assert.Equal(t, "ls1", lss[0].Name)
+		assert.Len(t, lss[0].Ports, 1)
+	}
+
+	// Deleting the switch garbage collects its port
+	cmd, err = ovndbapi.LSDel("ls1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = ovndbapi.Execute(cmd); err != nil {
+		t.Fatal(err)
+	}
+	srv.mu.Lock()
+	assert.Empty(t, srv.dbs["OVN_Northbound"].tables["Logical_Switch_Port"])
+	srv.mu.Unlock()
+	for i := 0; i < 50; i++ {
+		if lss, _ = other.LSList(); len(lss) == 0 {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.Empty(t, lss)
+}
+
+func TestServerSouthbound(t *testing.T) {
+	srv := newTestServer(t)
+	defer srv.Close()
+	ovndbapi := newTestClient(t, srv, goovn.DBSB)
+	defer ovndbapi.Close()
+
+	cmd, err := ovndbapi.ChassisAdd("ch1", "host1", []string{"geneve", "stt"}, "10.0.0.1", nil, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = ovndbapi.Execute(cmd); err != nil {
+		t.Fatal(err)
+	}
+	chassis, err := ovndbapi.ChassisGet("ch1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if assert.Len(t, chassis, 1) {
+		assert.Equal(t, "host1", chassis[0].Hostname)
+		assert.Len(t, chassis[0].Encaps, 2)
+	}
+}
+
+func TestServerTransact(t *testing.T) {
+	srv := newTestServer(t)
+	defer srv.Close()
+	c := newRPCClient(t, srv)
+
+	dbs, _ := c.call("list_dbs")
+	assert.Equal(t, []interface{}{"OVN_Northbound", "OVN_Southbound", "_Server"}, dbs)
+
+	results := c.transact(
+		map[string]interface{}{"op": "insert", "table": "Logical_Switch_Port", "uuid-name": "p1",
+			"row": map[string]interface{}{"name": "p1"}},
+		map[string]interface{}{"op": "insert", "table": "Logical_Switch", "uuid-name": "ls",
+			"row": map[string]interface{}{"name": "ls", "ports": []interface{}{"named-uuid", "p1"}}},
+		map[string]interface{}{"op": "insert", "table": "Logical_Switch_Port",
+			"row": map[string]interface{}{"name": "orphan"}},
+	)
+	assert.Len(t, results, 3)
+	lsp := results[0].(map[string]interface{})["uuid"].([]interface{})[1]
+	ls := results[1].(map[string]interface{})["uuid"].([]interface{})[1]
+
+	// The orphan port of a non-root table is garbage collected
+	results = c.transact(map[string]interface{}{"op": "select", "table": "Logical_Switch_Port",
+		"where": []interface{}{}, "columns": []interface{}{"name"}})
+	assert.Equal(t, []interface{}{map[string]interface{}{"name": "p1"}}, results[0].(map[string]interface{})["rows"])
+
+	// A port still referred to cannot be deleted
+	results = c.transact(map[string]interface{}{"op": "delete", "table": "Logical_Switch_Port",
+		"where": []interface{}{[]interface{}{"_uuid", "==", []interface{}{"uuid", lsp}}}})
+	assert.Len(t, results, 2)
+	assert.Equal(t, "referential integrity violation", resultError(results[1]))
+
+	// Port names are unique
+	results = c.transact(
+		map[string]interface{}{"op": "insert", "table": "Logical_Switch_Port", "uuid-name": "p2",
+			"row": map[string]interface{}{"name": "p1"}},
+		map[string]interface{}{"op": "mutate", "table": "Logical_Switch",
+			"where":     []interface{}{[]interface{}{"name", "==", "ls"}},
+			"mutations": []interface{}{[]interface{}{"ports", "insert", []interface{}{"named-uuid", "p2"}}}},
+	)
+	assert.Equal(t, "constraint violation", resultError(results[2]))
+
+	// A failed operation aborts the transaction
+	results = c.transact(
+		map[string]interface{}{"op": "update", "table": "Logical_Switch",
+			"where": []interface{}{[]interface{}{"name", "==", "ls"}},
+			"row":   map[string]interface{}{"name": "renamed"}},
+		map[string]interface{}{"op": "wait", "table": "Logical_Switch", "timeout": 0,
+			"where": []interface{}{[]interface{}{"name", "==", "renamed"}},
+			"until": "==", "rows": []interface{}{}},
+		map[string]interface{}{"op": "comment", "comment": "not executed"},
+	)
+	assert.Equal(t, map[string]interface{}{"count": json.Number("1")}, results[0])
+	assert.Equal(t, "timed out", resultError(results[1]))
+	assert.Nil(t, results[2])
+	results = c.transact(map[string]interface{}{"op": "select", "table": "Logical_Switch",
+		"where": []interface{}{}, "columns": []interface{}{"name"}})
+	assert.Equal(t, []interface{}{map[string]interface{}{"name": "ls"}}, results[0].(map[string]interface{})["rows"])
+
+	// The rows of a wait may include _uuid
+	results = c.transact(map[string]interface{}{"op": "wait", "table": "Logical_Switch", "timeout": 0,
+		"where":   []interface{}{[]interface{}{"name", "==", "ls"}},
+		"columns": []interface{}{"_uuid", "name"},
+		"until":   "==", "rows": []interface{}{map[string]interface{}{"_uuid": []interface{}{"uuid", ls}, "name": "ls"}}})
+	assert.Equal(t, map[string]interface{}{}, results[0])
+
+	// Map mutations and weak references
+	results = c.transact(
+		map[string]interface{}{"op": "insert", "table": "Load_Balancer", "uuid-name": "lb",
+			"row": map[string]interface{}{"name": "lb"}},
+		map[string]interface{}{"op": "mutate", "table": "Load_Balancer",
+			"where": []interface{}{[]interface{}{"name", "==", "lb"}},
+			"mutations": []interface{}{
+				[]interface{}{"vips", "insert", []interface{}{"map", []interface{}{
+					[]interface{}{"10.0.0.1:80", "10.0.1.1:80"},
+					[]interface{}{"10.0.0.2:80", "10.0.1.2:80"}}}},
+				[]interface{}{"vips", "delete", []interface{}{"set", []interface{}{"10.0.0.1:80"}}}}},
+		map[string]interface{}{"op": "mutate", "table": "Logical_Switch",
+			"where":     []interface{}{[]interface{}{"_uuid", "==", []interface{}{"uuid", ls}}},
+			"mutations": []interface{}{[]interface{}{"load_balancer", "insert", []interface{}{"named-uuid", "lb"}}}},
+	)
+	assert.Equal(t, "", resultError(results[len(results)-1]))
+	results = c.transact(map[string]interface{}{"op": "select", "table": "Load_Balancer",
+		"where": []interface{}{}, "columns": []interface{}{"vips"}})
+	assert.Equal(t, []interface{}{map[string]interface{}{"vips": []interface{}{"map", []interface{}{
+		[]interface{}{"10.0.0.2:80", "10.0.1.2:80"}}}}}, results[0].(map[string]interface{})["rows"])
+	c.transact(map[string]interface{}{"op": "delete", "table": "Load_Balancer", "where": []interface{}{}})
+	results = c.transact(map[string]interface{}{"op": "select", "table": "Logical_Switch",
+		"where": []interface{}{}, "columns": []interface{}{"load_balancer"}})
+	assert.Equal(t, []interface{}{map[string]interface{}{"load_balancer": []interface{}{"set", []interface{}{}}}},
+		results[0].(map[string]interface{})["rows"])
+}
+
+func TestServerMonitor(t *testing.T) {
+	srv := newTestServer(t)
+	defer srv.Close()
+	c := newRPCClient(t, srv)
+
+	c.transact(map[string]interface{}{"op": "insert", "table": "Address_Set",
+		"row": map[string]interface{}{"name": "as1"}})
+	initial, err := c.call("monitor", "OVN_Northbound", "m", map[string]interface{}{
+		"Address_Set": map[string]interface{}{"columns": []interface{}{"name", "addresses"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	rows := initial.(map[string]interface{})["Address_Set"].(map[string]interface{})
+	assert.Len(t, rows, 1)
+
+	// A wait with a timeout is retried until another transaction satisfies it
+	other := newRPCClient(t, srv)
+	done := make(chan []interface{})
+	go func() {
+		done <- other.transact(map[string]interface{}{"op": "wait", "table": "Address_Set", "timeout": 5000,
+			"where":   []interface{}{[]interface{}{"name", "==", "as1"}},
+			"columns": []interface{}{"addresses"}, "until": "==",
+			"rows": []interface{}{map[string]interface{}{"addresses": "10.0.0.1"}}})
+	}()
+	time.Sleep(50 * time.Millisecond)
+	c.transact(map[string]interface{}{"op": "mutate", "table": "Address_Set",
+		"where":     []interface{}{[]interface{}{"name", "==", "as1"}},
+		"mutations": []interface{}{[]interface{}{"addresses", "insert", "10.0.0.1"}}})
+	select {
+	case results := <-done:
+		assert.Equal(t, "", resultError(results[0]))
+	case <-time.After(5 * time.Second):
+		t.Fatal("wait was not satisfied")
+	}
+
+	if assert.Len(t, c.updates, 1) {
+		assert.Equal(t, "m", c.updates[0][0])
+		updates := c.updates[0][1].(map[string]interface{})["Address_Set"].(map[string]interface{})
+		for _, u := range updates {
+			assert.Equal(t, map[string]interface{}{
+				"old": map[string]interface{}{"addresses": []interface{}{"set", []interface{}{}}},
+				"new": map[string]interface{}{"name": "as1", "addresses": "10.0.0.1"},
+			}, u)
+		}
+	}
+
+	_, err = c.call("monitor_cancel", "m")
+	assert.Nil(t, err)
+	_, err = c.call("monitor_cancel", "m")
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,112 @@
+/**
+ * Copyright (c) 2020 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovntest
+
+import (
+	"fmt"
+	"sort"
+)
+
+// serverDatabase is the database of ovsdb-server reporting the state of the
+// databases it serves, e.g. whether it is the leader of a clustered one
+const serverDatabase = "_Server"
+
+// serverSchema is the _server.ovsschema of ovsdb-server
+const serverSchema = `{
+    "name": "_Server",
+    "version": "1.1.0",
+    "tables": {
+        "Database": {
+            "columns": {
+                "name": {"type": "string"},
+                "model": {
+                    "type": {"key": {"type": "string",
+                                     "enum": ["set", ["standalone", "clustered"]]}}},
+                "connected": {"type": "boolean"},
+                "leader": {"type": "boolean"},
+                "schema": {
+                    "type": {"key": {"type": "string"}, "min": 0, "max": 1}},
+                "cid": {"type": {"key": "uuid", "min": 0, "max": 1}},
+                "sid": {"type": {"key": "uuid", "min": 0, "max": 1}},
+                "index": {"type": {"key": "integer", "min": 0, "max": 1}}}}}}`
+
+// addServerDatabase adds the _Server database with a row for every other
+// database, they are standalone and thus always report to be the leader
+func (s *Server) addServerDatabase() error {
+	db, err := newDatabase([]byte(serverSchema))
+	if err != nil {
+		return err
+	}
+	names := make([]string, 0, len(s.dbs))
+	for name := range s.dbs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var ops []interface{}
+	for _, name := range names {
+		ops = append(ops, map[string]interface{}{
+			"op":    "insert",
+			"table": "Database",
+			"row": map[string]interface{}{
+				"name":      name,
+				"model":     "standalone",
+				"connected": true,
+				"leader":    true,
+				"schema":    string(s.dbs[name].raw),
+			},
+		})
+	}
+	results, _, _ := db.transact(ops, true)
+	if err := resultsError(results); err != nil {
+		return err
+	}
+	s.dbs[serverDatabase] = db
+	return nil
+}
+
+// SetLeader sets whether the server is the leader of db, which is reported
+// as clustered from then on. A client with Config.LeaderOnly disconnects
+// from a server that loses leadership and only connects to the leader.
+func (s *Server) SetLeader(db string, leader bool) error {
+	s.mu.Lock()
+	_, ok := s.dbs[db]
+	serverDB := s.dbs[serverDatabase]
+	s.mu.Unlock()
+	if !ok || db == serverDatabase {
+		return fmt.Errorf("unknown database %s", db)
+	}
+	results, err := s.transact(serverDB, []interface{}{map[string]interface{}{
+		"op":    "update",
+		"table": "Database",
+		"where": []interface{}{[]interface{}{"name", "==", db}},
+		"row":   map[string]interface{}{"model": "clustered", "leader": leader},
+	}})
+	if err != nil {
+		return err
+	}
+	return resultsError(results.([]interface{}))
+}
+
+// resultsError returns the first error of the results of a transaction
+func resultsError(results []interface{}) error {
+	for _, result := range results {
+		if err, ok := result.(*ovsdbError); ok {
+			return err
+		}
+	}
+	return nil
+}
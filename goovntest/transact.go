@@ -0,0 +1,1032 @@
+/**
+ * Copyright (c) 2020 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovntest
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// row maps column names, including _uuid and _version, to values. Rows are
+// never modified once stored, a changed row is a new row.
+type row map[string]datum
+
+func (r row) uuid() string {
+	return string(r["_uuid"].keys[0].(uuidAtom))
+}
+
+func (r row) clone() row {
+	c := make(row, len(r))
+	for k, v := range r {
+		c[k] = v
+	}
+	return c
+}
+
+// database is a database of the server
+type database struct {
+	schema *dbSchema
+	raw    []byte
+	tables map[string]map[string]row
+}
+
+func newDatabase(raw []byte) (*database, error) {
+	schema, err := parseSchema(raw)
+	if err != nil {
+		return nil, err
+	}
+	db := &database{schema: schema, raw: raw, tables: make(map[string]map[string]row)}
+	for name := range schema.Tables {
+		db.tables[name] = make(map[string]row)
+	}
+	return db, nil
+}
+
+// txn is a transaction in progress, tables are copied when first modified
+type txn struct {
+	db     *database
+	tables map[string]map[string]row
+	named  map[string]string
+	// defined are the uuid-names of the insert operations executed so far
+	defined map[string]bool
+	// blocked is set if a wait operation with a timeout was not satisfied
+	blocked bool
+}
+
+func newTxn(db *database) *txn {
+	return &txn{
+		db:      db,
+		tables:  make(map[string]map[string]row),
+		named:   make(map[string]string),
+		defined: make(map[string]bool),
+	}
+}
+
+// rows returns the rows of table as seen by the transaction
+func (t *txn) rows(table string) map[string]row {
+	if rows, ok := t.tables[table]; ok {
+		return rows
+	}
+	return t.db.tables[table]
+}
+
+// modify returns the rows of table for modification
+func (t *txn) modify(table string) map[string]row {
+	if rows, ok := t.tables[table]; ok {
+		return rows
+	}
+	rows := make(map[string]row, len(t.db.tables[table]))
+	for uuid, r := range t.db.tables[table] {
+		rows[uuid] = r
+	}
+	t.tables[table] = rows
+	return rows
+}
+
+// operation is an RFC 7047 <operation> decoded from json
+type operation map[string]interface{}
+
+func (o operation) str(member string, required bool) (string, error) {
+	v, ok := o[member]
+	if !ok {
+		if required {
+			return "", syntaxError("%s operation is missing %s", o["op"], member)
+		}
+		return "", nil
+	}
+	s, ok := v.(string)
+	if !ok {
+		return "", syntaxError("%s of %s operation is not a string", member, o["op"])
+	}
+	return s, nil
+}
+
+func (o operation) array(member string, required bool) ([]interface{}, error) {
+	v, ok := o[member]
+	if !ok {
+		if required {
+			return nil, syntaxError("%s operation is missing %s", o["op"], member)
+		}
+		return nil, nil
+	}
+	arr, ok := v.([]interface{})
+	if !ok {
+		return nil, syntaxError("%s of %s operation is not an array", member, o["op"])
+	}
+	return arr, nil
+}
+
+func (o operation) object(member string, required bool) (map[string]interface{}, error) {
+	v, ok := o[member]
+	if !ok {
+		if required {
+			return nil, syntaxError("%s operation is missing %s", o["op"], member)
+		}
+		return nil, nil
+	}
+	obj, ok := v.(map[string]interface{})
+	if !ok {
+		return nil, syntaxError("%s of %s operation is not an object", member, o["op"])
+	}
+	return obj, nil
+}
+
+func (o operation) table(db *database) (string, *tableSchema, error) {
+	name, err := o.str("table", true)
+	if err != nil {
+		return "", nil, err
+	}
+	ts, ok := db.schema.Tables[name]
+	if !ok {
+		return "", nil, syntaxError("unknown table %s", name)
+	}
+	return name, ts, nil
+}
+
+// transact executes ops in a transaction and commits it if they all succeed.
+// The results and the changes to notify are returned, changes is nil if the
+// transaction was not committed. If final is false and a wait operation is not
+// satisfied yet, blocked is returned true and nothing is done.
+func (db *database) transact(ops []interface{}, final bool) (results []interface{}, changes map[string]map[string]rowChange, blocked bool) {
+	t := newTxn(db)
+	// uuid-names can be referred to before their insert operation
+	for _, v := range ops {
+		if op, ok := v.(map[string]interface{}); ok && op["op"] == "insert" {
+			if name, ok := op["uuid-name"].(string); ok {
+				if _, ok := t.named[name]; !ok {
+					t.named[name] = newUUID(op)
+				}
+			}
+		}
+	}
+	results = make([]interface{}, 0, len(ops)+1)
+	failed := false
+	for _, v := range ops {
+		op, ok := v.(map[string]interface{})
+		if !ok {
+			results = append(results, syntaxError("operation is not an object"))
+			failed = true
+			break
+		}
+		result, err := t.execute(operation(op), final)
+		if t.blocked {
+			return nil, nil, true
+		}
+		if err != nil {
+			results = append(results, toOvsdbError(err))
+			failed = true
+			break
+		}
+		results = append(results, result)
+	}
+	if failed {
+		for len(results) < len(ops) {
+			results = append(results, nil)
+		}
+		return results, nil, false
+	}
+	changes, err := t.commit()
+	if err != nil {
+		return append(results, toOvsdbError(err)), nil, false
+	}
+	return results, changes, false
+}
+
+func toOvsdbError(err error) *ovsdbError {
+	if e, ok := err.(*ovsdbError); ok {
+		return e
+	}
+	return newError("unexpected error", "%v", err)
+}
+
+// newUUID returns the uuid requested by an insert operation or a random one
+func newUUID(op map[string]interface{}) string {
+	if id, ok := op["uuid"].(string); ok {
+		if _, err := uuid.Parse(id); err == nil {
+			return id
+		}
+	}
+	return uuid.New().String()
+}
+
+func (t *txn) execute(op operation, final bool) (map[string]interface{}, error) {
+	name, err := op.str("op", true)
+	if err != nil {
+		return nil, err
+	}
+	switch name {
+	case "insert":
+		return t.insert(op)
+	case "select":
+		return t.selectRows(op)
+	case "update":
+		return t.update(op)
+	case "mutate":
+		return t.mutate(op)
+	case "delete":
+		return t.delete(op)
+	case "wait":
+		return t.wait(op, final)
+	case "commit", "comment":
+		return map[string]interface{}{}, nil
+	case "abort":
+		return nil, newError("aborted", "aborted by request")
+	case "assert":
+		return nil, newError("not owner", "locks are not supported")
+	}
+	return nil, syntaxError("unknown operation %s", name)
+}
+
+// parseRow parses the columns of a row of ts, the values of the columns
+// must satisfy their type. Rows that are written must also satisfy the size
+// of the columns and cannot set _uuid or _version, the rows of a wait can.
+func (t *txn) parseRow(ts *tableSchema, obj map[string]interface{}, write bool) (row, error) {
+	r := make(row, len(obj))
+	for column, v := range obj {
+		col := ts.Columns[column]
+		if !write {
+			col = ts.column(column)
+		}
+		if col == nil {
+			return nil, syntaxError("unknown column %s", column)
+		}
+		d, err := parseDatum(&col.Type, v, t.named)
+		if err != nil {
+			return nil, err
+		}
+		if write {
+			if err := d.checkSize(&col.Type); err != nil {
+				return nil, err
+			}
+		}
+		r[column] = d
+	}
+	return r, nil
+}
+
+func (t *txn) insert(op operation) (map[string]interface{}, error) {
+	table, ts, err := op.table(t.db)
+	if err != nil {
+		return nil, err
+	}
+	obj, err := op.object("row", false)
+	if err != nil {
+		return nil, err
+	}
+	values, err := t.parseRow(ts, obj, true)
+	if err != nil {
+		return nil, err
+	}
+	name, err := op.str("uuid-name", false)
+	if err != nil {
+		return nil, err
+	}
+	var id string
+	if name != "" {
+		if t.defined[name] {
+			return nil, newError("duplicate uuid-name", "uuid-name %s is already defined", name)
+		}
+		t.defined[name] = true
+		id = t.named[name]
+	} else {
+		id = newUUID(op)
+	}
+	rows := t.modify(table)
+	if _, ok := rows[id]; ok {
+		return nil, newError("duplicate uuid", "row %s already exists in table %s", id, table)
+	}
+	r := make(row, len(ts.Columns)+2)
+	for column, col := range ts.Columns {
+		r[column] = defaultDatum(&col.Type)
+	}
+	for column, d := range values {
+		r[column] = d
+	}
+	r["_uuid"] = datum{keys: []interface{}{uuidAtom(id)}}
+	r["_version"] = datum{keys: []interface{}{uuidAtom(uuid.New().String())}}
+	rows[id] = r
+	return map[string]interface{}{"uuid": []interface{}{"uuid", id}}, nil
+}
+
+// condition is a parsed RFC 7047 <condition>
+type condition struct {
+	column   string
+	function string
+	value    datum
+}
+
+func (t *txn) parseWhere(ts *tableSchema, where []interface{}) ([]condition, error) {
+	conds := make([]condition, 0, len(where))
+	for _, v := range where {
+		c, ok := v.([]interface{})
+		if !ok || len(c) != 3 {
+			return nil, syntaxError("invalid condition %v", v)
+		}
+		column, _ := c[0].(string)
+		function, _ := c[1].(string)
+		col := ts.column(column)
+		if col == nil {
+			return nil, syntaxError("unknown column %v", c[0])
+		}
+		// The value of a condition is any number of elements of the
+		// type of the column
+		ct := col.Type
+		ct.Min, ct.Max = 0, unlimited
+		switch function {
+		case "==", "!=", "includes", "excludes":
+		case "<", "<=", ">", ">=":
+			if !col.Type.isScalar() || (ct.Key.Type != "integer" && ct.Key.Type != "real") {
+				return nil, syntaxError("function %s is not allowed on column %s", function, column)
+			}
+		default:
+			return nil, syntaxError("unknown function %v", c[1])
+		}
+		d, err := parseDatum(&ct, c[2], t.named)
+		if err != nil {
+			return nil, err
+		}
+		conds = append(conds, condition{column: column, function: function, value: d})
+	}
+	return conds, nil
+}
+
+func (c condition) match(r row) bool {
+	d := r[c.column]
+	switch c.function {
+	case "==":
+		return d.equal(c.value)
+	case "!=":
+		return !d.equal(c.value)
+	case "includes":
+		return d.includes(c.value)
+	case "excludes":
+		for i, key := range c.value.keys {
+			j := d.find(key)
+			if j >= 0 && (d.values == nil || compareAtoms(d.values[j], c.value.values[i]) == 0) {
+				return false
+			}
+		}
+		return true
+	}
+	if len(d.keys) != 1 || len(c.value.keys) != 1 {
+		return false
+	}
+	cmp := compareAtoms(d.keys[0], c.value.keys[0])
+	switch c.function {
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	case ">":
+		return cmp > 0
+	}
+	return cmp >= 0
+}
+
+// where returns the uuids of the rows of table matching the where of op
+// sorted so that results do not depend on map order
+func (t *txn) where(op operation, table string, ts *tableSchema) ([]string, error) {
+	where, err := op.array("where", true)
+	if err != nil {
+		return nil, err
+	}
+	conds, err := t.parseWhere(ts, where)
+	if err != nil {
+		return nil, err
+	}
+	rows := t.rows(table)
+	var uuids []string
+	// a condition on _uuid avoids a scan
+	if len(conds) > 0 && conds[0].column == "_uuid" && conds[0].function == "==" && len(conds[0].value.keys) == 1 {
+		id := string(conds[0].value.keys[0].(uuidAtom))
+		if _, ok := rows[id]; ok {
+			uuids = []string{id}
+		}
+	} else {
+		for id := range rows {
+			uuids = append(uuids, id)
+		}
+		sort.Strings(uuids)
+	}
+	matches := uuids[:0]
+	for _, id := range uuids {
+		ok := true
+		for _, c := range conds {
+			if !c.match(rows[id]) {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			matches = append(matches, id)
+		}
+	}
+	return matches, nil
+}
+
+func columnsOf(op operation, ts *tableSchema, all []string) ([]string, error) {
+	v, err := op.array("columns", false)
+	if err != nil {
+		return nil, err
+	}
+	if v == nil {
+		return all, nil
+	}
+	columns := make([]string, 0, len(v))
+	for _, c := range v {
+		column, _ := c.(string)
+		if ts.column(column) == nil {
+			return nil, syntaxError("unknown column %v", c)
+		}
+		columns = append(columns, column)
+	}
+	return columns, nil
+}
+
+func encodeRow(ts *tableSchema, r row, columns []string) map[string]interface{} {
+	obj := make(map[string]interface{}, len(columns))
+	for _, column := range columns {
+		obj[column] = encodeDatum(&ts.column(column).Type, r[column])
+	}
+	return obj
+}
+
+func (t *txn) selectRows(op operation) (map[string]interface{}, error) {
+	table, ts, err := op.table(t.db)
+	if err != nil {
+		return nil, err
+	}
+	uuids, err := t.where(op, table, ts)
+	if err != nil {
+		return nil, err
+	}
+	columns, err := columnsOf(op, ts, append([]string{"_uuid", "_version"}, ts.names...))
+	if err != nil {
+		return nil, err
+	}
+	rows := t.rows(table)
+	result := make([]interface{}, 0, len(uuids))
+	for _, id := range uuids {
+		result = append(result, encodeRow(ts, rows[id], columns))
+	}
+	return map[string]interface{}{"rows": result}, nil
+}
+
+func (t *txn) update(op operation) (map[string]interface{}, error) {
+	table, ts, err := op.table(t.db)
+	if err != nil {
+		return nil, err
+	}
+	obj, err := op.object("row", true)
+	if err != nil {
+		return nil, err
+	}
+	values, err := t.parseRow(ts, obj, true)
+	if err != nil {
+		return nil, err
+	}
+	for column := range values {
+		if !ts.Columns[column].mutable() {
+			return nil, constraintViolation("cannot update immutable column %s of table %s", column, table)
+		}
+	}
+	uuids, err := t.where(op, table, ts)
+	if err != nil {
+		return nil, err
+	}
+	rows := t.modify(table)
+	for _, id := range uuids {
+		r := rows[id].clone()
+		for column, d := range values {
+			r[column] = d
+		}
+		rows[id] = r
+	}
+	return map[string]interface{}{"count": len(uuids)}, nil
+}
+
+func (t *txn) delete(op operation) (map[string]interface{}, error) {
+	table, ts, err := op.table(t.db)
+	if err != nil {
+		return nil, err
+	}
+	uuids, err := t.where(op, table, ts)
+	if err != nil {
+		return nil, err
+	}
+	rows := t.modify(table)
+	for _, id := range uuids {
+		delete(rows, id)
+	}
+	return map[string]interface{}{"count": len(uuids)}, nil
+}
+
+// mutation is a parsed RFC 7047 <mutation>
+type mutation struct {
+	column  string
+	mutator string
+	value   datum
+	col     *columnSchema
+}
+
+func (t *txn) parseMutations(table string, ts *tableSchema, mutations []interface{}) ([]mutation, error) {
+	muts := make([]mutation, 0, len(mutations))
+	for _, v := range mutations {
+		m, ok := v.([]interface{})
+		if !ok || len(m) != 3 {
+			return nil, syntaxError("invalid mutation %v", v)
+		}
+		column, _ := m[0].(string)
+		mutator, _ := m[1].(string)
+		col, ok := ts.Columns[column]
+		if !ok {
+			return nil, syntaxError("unknown column %v", m[0])
+		}
+		if !col.mutable() {
+			return nil, constraintViolation("cannot mutate immutable column %s of table %s", column, table)
+		}
+		ct := col.Type
+		switch mutator {
+		case "+=", "-=", "*=", "/=", "%=":
+			if ct.isMap() || (ct.Key.Type != "integer" && ct.Key.Type != "real") ||
+				(mutator == "%=" && ct.Key.Type != "integer") {
+				return nil, syntaxError("mutator %s is not allowed on column %s", mutator, column)
+			}
+			// The operand is a single atom, without the range of the key
+			ct = columnType{Key: baseType{Type: ct.Key.Type}, Min: 1, Max: 1}
+		case "insert":
+			ct.Min, ct.Max = 0, unlimited
+		case "delete":
+			ct.Min, ct.Max = 0, unlimited
+			if ct.isMap() {
+				// Either a map or a set of keys
+				if arr, ok := m[2].([]interface{}); !ok || len(arr) == 0 || arr[0] != "map" {
+					ct.Value = nil
+				}
+			}
+		default:
+			return nil, syntaxError("unknown mutator %v", m[1])
+		}
+		d, err := parseDatum(&ct, m[2], t.named)
+		if err != nil {
+			return nil, err
+		}
+		muts = append(muts, mutation{column: column, mutator: mutator, value: d, col: col})
+	}
+	return muts, nil
+}
+
+func (m mutation) apply(d datum) (datum, error) {
+	switch m.mutator {
+	case "insert":
+		d = d.clone()
+		for i, key := range m.value.keys {
+			if d.find(key) >= 0 {
+				continue
+			}
+			d.keys = append(d.keys, key)
+			if m.col.Type.isMap() {
+				d.values = append(d.values, m.value.values[i])
+			}
+		}
+		d.sort()
+		return d, nil
+	case "delete":
+		var out datum
+		for i, key := range d.keys {
+			j := m.value.find(key)
+			if j >= 0 && (m.value.values == nil || compareAtoms(m.value.values[j], d.values[i]) == 0) {
+				continue
+			}
+			out.keys = append(out.keys, key)
+			if m.col.Type.isMap() {
+				out.values = append(out.values, d.values[i])
+			}
+		}
+		return out, nil
+	}
+	d = d.clone()
+	operand := m.value.keys[0]
+	for i, atom := range d.keys {
+		switch a := atom.(type) {
+		case int64:
+			b := operand.(int64)
+			switch m.mutator {
+			case "+=":
+				if (b > 0 && a > math.MaxInt64-b) || (b < 0 && a < math.MinInt64-b) {
+					return d, newError("range error", "result of %d %s %d overflows", a, m.mutator, b)
+				}
+				a += b
+			case "-=":
+				if (b < 0 && a > math.MaxInt64+b) || (b > 0 && a < math.MinInt64+b) {
+					return d, newError("range error", "result of %d %s %d overflows", a, m.mutator, b)
+				}
+				a -= b
+			case "*=":
+				if a != 0 && ((a*b)/a != b || (a == -1 && b == math.MinInt64) || (b == -1 && a == math.MinInt64)) {
+					return d, newError("range error", "result of %d %s %d overflows", a, m.mutator, b)
+				}
+				a *= b
+			case "/=", "%=":
+				if b == 0 {
+					return d, newError("domain error", "division by zero")
+				}
+				if m.mutator == "/=" {
+					a /= b
+				} else {
+					a %= b
+				}
+			}
+			d.keys[i] = a
+		case float64:
+			b := operand.(float64)
+			switch m.mutator {
+			case "+=":
+				a += b
+			case "-=":
+				a -= b
+			case "*=":
+				a *= b
+			case "/=":
+				if b == 0 {
+					return d, newError("domain error", "division by zero")
+				}
+				a /= b
+			}
+			if math.IsInf(a, 0) || math.IsNaN(a) {
+				return d, newError("range error", "result of %s is not a number", m.mutator)
+			}
+			d.keys[i] = a
+		}
+	}
+	// The result must still satisfy the constraints of the column
+	for _, key := range d.keys {
+		if err := checkAtom(&m.col.Type.Key, key); err != nil {
+			return d, err
+		}
+	}
+	if err := d.sort(); err != nil {
+		return d, constraintViolation("mutation of column %s results in duplicate values", m.column)
+	}
+	return d, nil
+}
+
+func (t *txn) mutate(op operation) (map[string]interface{}, error) {
+	table, ts, err := op.table(t.db)
+	if err != nil {
+		return nil, err
+	}
+	mutations, err := op.array("mutations", true)
+	if err != nil {
+		return nil, err
+	}
+	muts, err := t.parseMutations(table, ts, mutations)
+	if err != nil {
+		return nil, err
+	}
+	uuids, err := t.where(op, table, ts)
+	if err != nil {
+		return nil, err
+	}
+	rows := t.modify(table)
+	for _, id := range uuids {
+		r := rows[id].clone()
+		for _, m := range muts {
+			d, err := m.apply(r[m.column])
+			if err != nil {
+				return nil, err
+			}
+			if err := d.checkSize(&m.col.Type); err != nil {
+				return nil, err
+			}
+			r[m.column] = d
+		}
+		rows[id] = r
+	}
+	return map[string]interface{}{"count": len(uuids)}, nil
+}
+
+func (t *txn) wait(op operation, final bool) (map[string]interface{}, error) {
+	table, ts, err := op.table(t.db)
+	if err != nil {
+		return nil, err
+	}
+	uuids, err := t.where(op, table, ts)
+	if err != nil {
+		return nil, err
+	}
+	columns, err := columnsOf(op, ts, ts.names)
+	if err != nil {
+		return nil, err
+	}
+	until, err := op.str("until", true)
+	if err != nil {
+		return nil, err
+	}
+	if until != "==" && until != "!=" {
+		return nil, syntaxError("invalid until %s", until)
+	}
+	expected, err := op.array("rows", true)
+	if err != nil {
+		return nil, err
+	}
+	var want []row
+	for _, v := range expected {
+		obj, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, syntaxError("invalid row %v", v)
+		}
+		r, err := t.parseRow(ts, obj, false)
+		if err != nil {
+			return nil, err
+		}
+		want = append(want, r)
+	}
+	var timeout time.Duration
+	if v, ok := op["timeout"]; ok {
+		ms, err := parseAtom(&baseType{Type: "integer"}, v, nil)
+		if err != nil {
+			return nil, err
+		}
+		timeout = time.Duration(ms.(int64)) * time.Millisecond
+	}
+
+	rows := t.rows(table)
+	equal := len(uuids) == len(want)
+	for _, w := range want {
+		found := false
+		for _, id := range uuids {
+			if projectedEqual(rows[id], w, columns) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			equal = false
+			break
+		}
+	}
+	if equal == (until == "==") {
+		return map[string]interface{}{}, nil
+	}
+	if timeout > 0 && !final {
+		t.blocked = true
+		return nil, nil
+	}
+	return nil, newError("timed out", "\"wait\" timed out")
+}
+
+// projectedEqual compares the columns of r to the columns of w
+func projectedEqual(r, w row, columns []string) bool {
+	for _, column := range columns {
+		wd, ok := w[column]
+		if !ok {
+			continue
+		}
+		if !r[column].equal(wd) {
+			return false
+		}
+	}
+	return true
+}
+
+// waitTimeout returns the longest timeout of the wait operations in ops
+func waitTimeout(ops []interface{}) time.Duration {
+	var timeout time.Duration
+	for _, v := range ops {
+		op, ok := v.(map[string]interface{})
+		if !ok || op["op"] != "wait" {
+			continue
+		}
+		if ms, err := parseAtom(&baseType{Type: "integer"}, op["timeout"], nil); err == nil {
+			if d := time.Duration(ms.(int64)) * time.Millisecond; d > timeout {
+				timeout = d
+			}
+		}
+	}
+	return timeout
+}
+
+// rowChange is the change of a row by a transaction, old is nil for an
+// inserted row and new is nil for a deleted row
+type rowChange struct {
+	old, new row
+}
+
+// refs calls f for each uuid of r referring to another table
+func (ts *tableSchema) refs(r row, f func(column, refTable, refType string, ref string)) {
+	for _, column := range ts.names {
+		col := ts.Columns[column]
+		d := r[column]
+		if col.Type.Key.RefTable != "" {
+			for _, key := range d.keys {
+				f(column, col.Type.Key.RefTable, col.Type.Key.RefType, string(key.(uuidAtom)))
+			}
+		}
+		if v := col.Type.Value; v != nil && v.RefTable != "" {
+			for _, value := range d.values {
+				f(column, v.RefTable, v.RefType, string(value.(uuidAtom)))
+			}
+		}
+	}
+}
+
+// commit garbage collects the rows of non-root tables that are no longer
+// referred to, removes weak references to deleted rows and checks the
+// referential integrity and the constraints of the database. The database
+// is only updated if every check passes.
+func (t *txn) commit() (map[string]map[string]rowChange, error) {
+	if len(t.tables) == 0 {
+		return nil, nil
+	}
+	schema := t.db.schema
+	tables := make([]string, 0, len(schema.Tables))
+	for name := range schema.Tables {
+		tables = append(tables, name)
+	}
+	sort.Strings(tables)
+
+	// Garbage collection, deleting a row may orphan the rows it refers to
+	for {
+		referred := make(map[string]bool)
+		for _, name := range tables {
+			ts := schema.Tables[name]
+			for _, r := range t.rows(name) {
+				ts.refs(r, func(_, _, refType, ref string) {
+					if refType == "strong" {
+						referred[ref] = true
+					}
+				})
+			}
+		}
+		collected := false
+		for _, name := range tables {
+			if schema.Tables[name].root {
+				continue
+			}
+			for id := range t.rows(name) {
+				if !referred[id] {
+					delete(t.modify(name), id)
+					collected = true
+				}
+			}
+		}
+		if !collected {
+			break
+		}
+	}
+
+	// Weak references to deleted rows are removed, strong ones are errors
+	for _, name := range tables {
+		ts := schema.Tables[name]
+		for id, r := range t.rows(name) {
+			var weak map[string]map[string]bool
+			var err error
+			ts.refs(r, func(column, refTable, refType, ref string) {
+				if _, ok := t.rows(refTable)[ref]; ok || err != nil {
+					return
+				}
+				if refType == "weak" {
+					if weak == nil {
+						weak = make(map[string]map[string]bool)
+					}
+					if weak[column] == nil {
+						weak[column] = make(map[string]bool)
+					}
+					weak[column][ref] = true
+					return
+				}
+				if _, existed := t.db.tables[refTable][ref]; existed {
+					err = newError("referential integrity violation",
+						"cannot delete %s row %s because of remaining reference from column %s of %s row %s",
+						refTable, ref, column, name, id)
+				} else {
+					err = newError("referential integrity violation",
+						"table %s column %s row %s references nonexistent row %s in table %s",
+						name, column, id, ref, refTable)
+				}
+			})
+			if err != nil {
+				return nil, err
+			}
+			if weak == nil {
+				continue
+			}
+			r = r.clone()
+			for column, dangling := range weak {
+				col := ts.Columns[column]
+				var out datum
+				d := r[column]
+				for i, key := range d.keys {
+					if col.Type.Key.RefTable != "" && dangling[string(key.(uuidAtom))] {
+						continue
+					}
+					if col.Type.isMap() {
+						if col.Type.Value.RefTable != "" && dangling[string(d.values[i].(uuidAtom))] {
+							continue
+						}
+						out.values = append(out.values, d.values[i])
+					}
+					out.keys = append(out.keys, key)
+				}
+				r[column] = out
+			}
+			t.modify(name)[id] = r
+		}
+	}
+
+	changes := make(map[string]map[string]rowChange)
+	for name, rows := range t.tables {
+		old := t.db.tables[name]
+		tableChanges := make(map[string]rowChange)
+		for id, r := range rows {
+			o, ok := old[id]
+			if !ok {
+				tableChanges[id] = rowChange{new: r}
+			} else if !rowEqual(o, r) {
+				r["_version"] = datum{keys: []interface{}{uuidAtom(uuid.New().String())}}
+				tableChanges[id] = rowChange{old: o, new: r}
+			}
+		}
+		for id, o := range old {
+			if _, ok := rows[id]; !ok {
+				tableChanges[id] = rowChange{old: o}
+			}
+		}
+		if len(tableChanges) > 0 {
+			changes[name] = tableChanges
+		}
+	}
+	if err := t.checkConstraints(changes); err != nil {
+		return nil, err
+	}
+	for name := range changes {
+		t.db.tables[name] = t.tables[name]
+	}
+	return changes, nil
+}
+
+// rowEqual compares the columns of two rows except _version
+func rowEqual(a, b row) bool {
+	for column, d := range a {
+		if column != "_version" && !d.equal(b[column]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (t *txn) checkConstraints(changes map[string]map[string]rowChange) error {
+	for name, tableChanges := range changes {
+		ts := t.db.schema.Tables[name]
+		rows := t.tables[name]
+		for _, change := range tableChanges {
+			if change.new == nil {
+				continue
+			}
+			for _, column := range ts.names {
+				if err := change.new[column].checkSize(&ts.Columns[column].Type); err != nil {
+					return constraintViolation("column %s of %s row %s has %s", column, name, change.new.uuid(), err.(*ovsdbError).Details)
+				}
+			}
+		}
+		if ts.MaxRows > 0 && len(rows) > ts.MaxRows {
+			return constraintViolation("transaction causes %q table to contain %d rows, greater than the schema-defined limit of %d row(s)",
+				name, len(rows), ts.MaxRows)
+		}
+		for _, index := range ts.Indexes {
+			seen := make(map[string]string, len(rows))
+			for id, r := range rows {
+				var key strings.Builder
+				for _, column := range index {
+					fmt.Fprintf(&key, "%v;", encodeDatum(&ts.Columns[column].Type, r[column]))
+				}
+				if other, ok := seen[key.String()]; ok {
+					return constraintViolation("Transaction causes multiple rows in %q table to have identical values (%s) for index on column %q.  First row has UUID %s, second row has UUID %s.",
+						name, strings.TrimSuffix(key.String(), ";"), strings.Join(index, ", "), other, id)
+				}
+				seen[key.String()] = id
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,274 @@
+/**
+ * Copyright (c) 2020 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn
+
+import (
+	"sort"
+
+	"github.com/ebay/libovsdb"
+)
+
+// HAChassisGroup ovnnb item
+type HAChassisGroup struct {
+	UUID       string
+	Name       string
+	HAChassis  []*HAChassis
+	ExternalID map[interface{}]interface{}
+	PopulatedColumns
+}
+
+// HAChassis ovnnb item
+type HAChassis struct {
+	UUID        string
+	ChassisName string
+	Priority    int
+	ExternalID  map[interface{}]interface{}
+	PopulatedColumns
+}
+
+func (odbi *ovndb) haChassisGroupAddImp(group string, external_ids map[string]string) (*OvnCommand, error) {
+	row := make(OVNRow)
+	row["name"] = group
+
+	if uuid := odbi.getRowUUID(TableHAChassisGroup, row); len(uuid) > 0 {
+		return nil, ErrorExist
+	}
+
+	if external_ids != nil {
+		oMap, err := libovsdb.NewOvsMap(external_ids)
+		if err != nil {
+			return nil, err
+		}
+		row["external_ids"] = oMap
+	}
+	insertOp := libovsdb.Operation{
+		Op:    opInsert,
+		Table: TableHAChassisGroup,
+		Row:   row,
+	}
+	operations := []libovsdb.Operation{insertOp}
+	return &OvnCommand{operations, odbi, make([][]map[string]interface{}, len(operations))}, nil
+}
+
+func (odbi *ovndb) haChassisGroupDelImp(group string) (*OvnCommand, error) {
+	row := make(OVNRow)
+	row["name"] = group
+
+	if uuid := odbi.getRowUUID(TableHAChassisGroup, row); len(uuid) == 0 {
+		return nil, ErrorNotFound
+	}
+
+	condition := libovsdb.NewCondition("name", "==", group)
+	deleteOp := libovsdb.Operation{
+		Op:    opDelete,
+		Table: TableHAChassisGroup,
+		Where: []interface{}{condition},
+	}
+	operations := []libovsdb.Operation{deleteOp}
+	return &OvnCommand{operations, odbi, make([][]map[string]interface{}, len(operations))}, nil
+}
+
+// haChassisUUID returns the UUID of the HA chassis of the group on chassis,
+// or "" if there is none. The cache must be locked by the caller.
+func (odbi *ovndb) haChassisUUID(groupUUID, chassis string) string {
+	for _, uuid := range rowSet(odbi.cache[TableHAChassisGroup][groupUUID], "ha_chassis") {
+		if rowString(odbi.cache[TableHAChassis][uuid], "chassis_name") == chassis {
+			return uuid
+		}
+	}
+	return ""
+}
+
+func (odbi *ovndb) haChassisGroupAddChassisImp(group, chassis string, priority int) (*OvnCommand, error) {
+	groupUUID := odbi.getRowUUID(TableHAChassisGroup, OVNRow{"name": group})
+	if len(groupUUID) == 0 {
+		return nil, ErrorNotFound
+	}
+
+	odbi.cachemutex.RLock()
+	haUUID := odbi.haChassisUUID(groupUUID, chassis)
+	odbi.cachemutex.RUnlock()
+
+	// an existing HA chassis only gets its priority updated
+	if len(haUUID) > 0 {
+		updateOp := libovsdb.Operation{
+			Op:    opUpdate,
+			Table: TableHAChassis,
+			Row:   OVNRow{"priority": priority},
+			Where: []interface{}{libovsdb.NewCondition("_uuid", "==", stringToGoUUID(haUUID))},
+		}
+		operations := []libovsdb.Operation{updateOp}
+		return &OvnCommand{operations, odbi, make([][]map[string]interface{}, len(operations))}, nil
+	}
+
+	namedUUID, err := newRowUUID()
+	if err != nil {
+		return nil, err
+	}
+	row := make(OVNRow)
+	row["chassis_name"] = chassis
+	row["priority"] = priority
+	insertOp := libovsdb.Operation{
+		Op:       opInsert,
+		Table:    TableHAChassis,
+		Row:      row,
+		UUIDName: namedUUID,
+	}
+
+	mutateSet, err := libovsdb.NewOvsSet([]libovsdb.UUID{stringToGoUUID(namedUUID)})
+	if err != nil {
+		return nil, err
+	}
+	mutation := libovsdb.NewMutation("ha_chassis", opInsert, mutateSet)
+	condition := libovsdb.NewCondition("name", "==", group)
+	mutateOp := libovsdb.Operation{
+		Op:        opMutate,
+		Table:     TableHAChassisGroup,
+		Mutations: []interface{}{mutation},
+		Where:     []interface{}{condition},
+	}
+	operations := []libovsdb.Operation{insertOp, mutateOp}
+	return &OvnCommand{operations, odbi, make([][]map[string]interface{}, len(operations))}, nil
+}
+
+func (odbi *ovndb) haChassisGroupRemoveChassisImp(group, chassis string) (*OvnCommand, error) {
+	groupUUID := odbi.getRowUUID(TableHAChassisGroup, OVNRow{"name": group})
+	if len(groupUUID) == 0 {
+		return nil, ErrorNotFound
+	}
+
+	odbi.cachemutex.RLock()
+	haUUID := odbi.haChassisUUID(groupUUID, chassis)
+	odbi.cachemutex.RUnlock()
+	if len(haUUID) == 0 {
+		return nil, ErrorNotFound
+	}
+
+	// the unreferenced HA_Chassis row is garbage collected
+	mutateSet, err := libovsdb.NewOvsSet([]libovsdb.UUID{stringToGoUUID(haUUID)})
+	if err != nil {
+		return nil, err
+	}
+	mutation := libovsdb.NewMutation("ha_chassis", opDelete, mutateSet)
+	condition := libovsdb.NewCondition("name", "==", group)
+	mutateOp := libovsdb.Operation{
+		Op:        opMutate,
+		Table:     TableHAChassisGroup,
+		Mutations: []interface{}{mutation},
+		Where:     []interface{}{condition},
+	}
+	operations := []libovsdb.Operation{mutateOp}
+	return &OvnCommand{operations, odbi, make([][]map[string]interface{}, len(operations))}, nil
+}
+
+// Set the HA chassis group of lrp, an empty group clears it
+func (odbi *ovndb) lrpSetHAChassisGroupImp(lrp, group string) (*OvnCommand, error) {
+	if uuid := odbi.getRowUUID(TableLogicalRouterPort, OVNRow{"name": lrp}); len(uuid) == 0 {
+		return nil, ErrorNotFound
+	}
+
+	value := interface{}(libovsdb.OvsSet{GoSet: []interface{}{}})
+	if group != "" {
+		groupUUID := odbi.getRowUUID(TableHAChassisGroup, OVNRow{"name": group})
+		if len(groupUUID) == 0 {
+			return nil, ErrorNotFound
+		}
+		value = stringToGoUUID(groupUUID)
+	}
+	updateOp := libovsdb.Operation{
+		Op:    opUpdate,
+		Table: TableLogicalRouterPort,
+		Row:   OVNRow{"ha_chassis_group": value},
+		Where: []interface{}{libovsdb.NewCondition("name", "==", lrp)},
+	}
+	operations := []libovsdb.Operation{updateOp}
+	return &OvnCommand{operations, odbi, make([][]map[string]interface{}, len(operations))}, nil
+}
+
+func (odbi *ovndb) haChassisGroupGetImp(group string) (*HAChassisGroup, error) {
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+
+	cacheHAChassisGroup, ok := odbi.cache[TableHAChassisGroup]
+	if !ok {
+		return nil, ErrorSchema
+	}
+
+	for uuid, drows := range cacheHAChassisGroup {
+		if name, ok := drows.Fields["name"].(string); ok && name == group {
+			return odbi.rowToHAChassisGroup(uuid), nil
+		}
+	}
+	return nil, ErrorNotFound
+}
+
+func (odbi *ovndb) haChassisGroupListImp() ([]*HAChassisGroup, error) {
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+
+	cacheHAChassisGroup, ok := odbi.cache[TableHAChassisGroup]
+	if !ok {
+		return nil, ErrorSchema
+	}
+
+	listGroup := make([]*HAChassisGroup, 0, len(cacheHAChassisGroup))
+	for uuid := range cacheHAChassisGroup {
+		listGroup = append(listGroup, odbi.rowToHAChassisGroup(uuid))
+	}
+	return listGroup, nil
+}
+
+// rowToHAChassisGroup resolves the HA chassis of the group, ordered by
+// descending priority
+func (odbi *ovndb) rowToHAChassisGroup(uuid string) *HAChassisGroup {
+	cacheHAChassisGroup, ok := odbi.cache[TableHAChassisGroup][uuid]
+	if !ok {
+		return nil
+	}
+
+	group := &HAChassisGroup{
+		UUID:       uuid,
+		Name:       rowString(cacheHAChassisGroup, "name"),
+		ExternalID: rowMap(cacheHAChassisGroup, "external_ids"),
+	}
+	for _, haUUID := range rowSet(cacheHAChassisGroup, "ha_chassis") {
+		if ha := odbi.rowToHAChassis(haUUID); ha != nil {
+			group.HAChassis = append(group.HAChassis, ha)
+		}
+	}
+	sort.SliceStable(group.HAChassis, func(i, j int) bool {
+		return group.HAChassis[i].Priority > group.HAChassis[j].Priority
+	})
+	group.PopulatedColumns = odbi.populated[TableHAChassisGroup]
+	return group
+}
+
+func (odbi *ovndb) rowToHAChassis(uuid string) *HAChassis {
+	cacheHAChassis, ok := odbi.cache[TableHAChassis][uuid]
+	if !ok {
+		return nil
+	}
+
+	ha := &HAChassis{
+		UUID:        uuid,
+		ChassisName: rowString(cacheHAChassis, "chassis_name"),
+		Priority:    rowInt(cacheHAChassis, "priority"),
+		ExternalID:  rowMap(cacheHAChassis, "external_ids"),
+	}
+	ha.PopulatedColumns = odbi.populated[TableHAChassis]
+	return ha
+}
@@ -0,0 +1,125 @@
+/**
+ * Copyright (c) 2020 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const HA_GROUP = "TEST_HA_GROUP"
+
+func TestHAChassisGroup(t *testing.T) {
+	ovndbapi := getOVNClient(DBNB)
+	defer ovndbapi.Close()
+
+	t.Logf("Adding HA chassis group %s", HA_GROUP)
+	cmd, err := ovndbapi.HAChassisGroupAdd(HA_GROUP, map[string]string{FOO: BAR})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = ovndbapi.HAChassisGroupAdd(HA_GROUP, nil)
+	assert.Equal(t, ErrorExist, err)
+
+	for chassis, priority := range map[string]int{GW_CHASSIS1: 10, GW_CHASSIS2: 20} {
+		cmd, err = ovndbapi.HAChassisGroupAddChassis(HA_GROUP, chassis, priority)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = ovndbapi.Execute(cmd)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	group, err := ovndbapi.HAChassisGroupGet(HA_GROUP)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, BAR, group.ExternalID[FOO])
+	assert.Equal(t, 2, len(group.HAChassis))
+	assert.Equal(t, GW_CHASSIS2, group.HAChassis[0].ChassisName)
+	assert.Equal(t, 20, group.HAChassis[0].Priority)
+
+	cmd, err = ovndbapi.HAChassisGroupRemoveChassis(HA_GROUP, GW_CHASSIS2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	groups, err := ovndbapi.HAChassisGroupList()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 1, len(groups))
+	assert.Equal(t, 1, len(groups[0].HAChassis))
+	assert.Equal(t, GW_CHASSIS1, groups[0].HAChassis[0].ChassisName)
+
+	t.Logf("Setting HA chassis group of %s", LRP)
+	cmd, err = ovndbapi.LRAdd(LR, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmd, err = ovndbapi.LRPAdd(LR, LRP, "54:54:54:54:54:54", []string{"192.168.0.1/24"}, "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmd, err = ovndbapi.LRPSetHAChassisGroup(LRP, HA_GROUP)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lrps, err := ovndbapi.LRPList(LR)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, group.UUID, lrps[0].HAChassisGroup)
+
+	cmd, err = ovndbapi.LRDel(LR)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmd, err = ovndbapi.HAChassisGroupDel(HA_GROUP)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,34 @@
+/**
+ * Copyright (c) 2020 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+// Package transact lets goovnmock answer the transact requests of a goovn
+// client without an exported API of goovn for it.
+package transact
+
+import (
+	"github.com/ebay/libovsdb"
+)
+
+// Func answers a transact request with the operations ops on db in place of
+// the db server
+type Func func(db string, ops []libovsdb.Operation) ([]libovsdb.OperationResult, error)
+
+// SetFunc is set by goovn. It makes every write of client, i.e. Execute, the
+// commit of a Transaction, WaitForSync, Reconcile, Import and so on, call f
+// instead of sending its transact request to the db server. The cache of
+// client is not changed by the writes since the db is not. client must be
+// created by goovn.NewClient.
+var SetFunc func(client interface{}, f Func) error
@@ -30,6 +30,7 @@ type LoadBalancer struct {
 	Protocol        string
 	SelectionFields string
 	ExternalID      map[interface{}]interface{}
+	PopulatedColumns
 }
 
 func (odbi *ovndb) lbUpdateImp(name string, vipPort string, protocol string, addrs []string) (*OvnCommand, error) {
@@ -204,14 +205,15 @@ func (odbi *ovndb) rowToLB(uuid string) (*LoadBalancer, error) {
 
 	lb := &LoadBalancer{
 		UUID:       uuid,
-		Protocol:   cacheLoadBalancer.Fields["protocol"].(string),
-		Name:       cacheLoadBalancer.Fields["name"].(string),
-		VIPs:       cacheLoadBalancer.Fields["vips"].(libovsdb.OvsMap).GoMap,
-		ExternalID: cacheLoadBalancer.Fields["external_ids"].(libovsdb.OvsMap).GoMap,
+		Protocol:   rowString(cacheLoadBalancer, "protocol"),
+		Name:       rowString(cacheLoadBalancer, "name"),
+		VIPs:       rowMap(cacheLoadBalancer, "vips"),
+		ExternalID: rowMap(cacheLoadBalancer, "external_ids"),
 	}
 
 	if fields, ok := cacheLoadBalancer.Fields["selection_fields"].(string); ok {
 		lb.SelectionFields = fields
 	}
+	lb.PopulatedColumns = odbi.populated[TableLoadBalancer]
 	return lb, nil
 }
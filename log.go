@@ -0,0 +1,62 @@
+/**
+ * Copyright (c) 2020 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn
+
+import (
+	"fmt"
+	"log"
+	"strings"
+)
+
+// Logger is the structured logger of a client. It is a subset of
+// logr.Logger, so a logr.Logger can be used as is, and other loggers need a
+// small adapter. Messages are constant and variable data is passed as
+// alternating keys and values.
+type Logger interface {
+	Info(msg string, keysAndValues ...interface{})
+	Error(err error, msg string, keysAndValues ...interface{})
+}
+
+// stdLogger logs errors with the standard log package and drops the other
+// messages, it is used if Config.Logger is not set
+type stdLogger struct{}
+
+func (stdLogger) Info(msg string, keysAndValues ...interface{}) {}
+
+func (stdLogger) Error(err error, msg string, keysAndValues ...interface{}) {
+	log.Println(formatLog(msg, append(keysAndValues, "error", err)))
+}
+
+func formatLog(msg string, keysAndValues []interface{}) string {
+	var sb strings.Builder
+	sb.WriteString(msg)
+	for i := 0; i < len(keysAndValues); i += 2 {
+		if i+1 < len(keysAndValues) {
+			fmt.Fprintf(&sb, " %v=%v", keysAndValues[i], keysAndValues[i+1])
+		} else {
+			fmt.Fprintf(&sb, " %v", keysAndValues[i])
+		}
+	}
+	return sb.String()
+}
+
+// debug logs to Config.DebugLogger if it is set
+func (odbi *ovndb) debug(msg string, keysAndValues ...interface{}) {
+	if odbi.debugLogger != nil {
+		odbi.debugLogger.Info(msg, keysAndValues...)
+	}
+}
@@ -0,0 +1,124 @@
+/**
+ * Copyright (c) 2020 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn
+
+import (
+	"bytes"
+	"errors"
+	"log"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/ebay/libovsdb"
+	"github.com/stretchr/testify/assert"
+)
+
+type testLogger struct {
+	mutex    sync.Mutex
+	messages []string
+}
+
+func (l *testLogger) Info(msg string, keysAndValues ...interface{}) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.messages = append(l.messages, msg)
+}
+
+func (l *testLogger) Error(err error, msg string, keysAndValues ...interface{}) {
+	l.Info(msg, keysAndValues...)
+}
+
+func TestFormatLog(t *testing.T) {
+	assert.Equal(t, "reconnected addr=unix:/tmp/nb.sock attempts=2",
+		formatLog("reconnected", []interface{}{"addr", "unix:/tmp/nb.sock", "attempts", 2}))
+	assert.Equal(t, "msg key", formatLog("msg", []interface{}{"key"}))
+}
+
+func TestStdLogger(t *testing.T) {
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	flags := log.Flags()
+	log.SetFlags(0)
+	defer func() {
+		log.SetOutput(os.Stderr)
+		log.SetFlags(flags)
+	}()
+	stdLogger{}.Info("connected", "db", DBNB)
+	stdLogger{}.Error(errors.New("EOF"), "disconnected", "db", DBNB)
+	assert.Equal(t, "disconnected db=OVN_Northbound error=EOF\n", buf.String())
+}
+
+func TestDebugLogger(t *testing.T) {
+	logger := &testLogger{}
+	odbi := &ovndb{
+		ovndbConfig: ovndbConfig{
+			tableCols:   map[string][]string{TableLogicalSwitchPort: {}},
+			debugLogger: logger,
+		},
+		cache: make(map[string]map[string]libovsdb.Row),
+	}
+	odbi.populateCache(libovsdb.TableUpdates{Updates: map[string]libovsdb.TableUpdate{
+		TableLogicalSwitchPort: {Rows: map[string]libovsdb.RowUpdate{
+			"lsp1": {New: lspRow("lsp1", map[string]string{FOO: BAR})},
+		}},
+	}})
+	assert.Equal(t, []string{"row update"}, logger.messages)
+}
+
+func TestClientLogger(t *testing.T) {
+	logger := &testLogger{}
+	debugLogger := &testLogger{}
+	cfg := buildOvnDbConfig(DBNB)
+	cfg.Logger = logger
+	cfg.DebugLogger = debugLogger
+	api, err := NewClient(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer api.Close()
+	assert.Contains(t, logger.messages, "connected")
+
+	cmd, err := api.LSAdd(LSW)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = api.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmd, err = api.LSDel(LSW)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = api.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	debugLogger.mutex.Lock()
+	defer debugLogger.mutex.Unlock()
+	assert.Contains(t, debugLogger.messages, "transact")
+	assert.Contains(t, debugLogger.messages, "transact reply")
+}
+
+func lspRow(name string, extIds map[string]string) libovsdb.Row {
+	oMap, _ := libovsdb.NewOvsMap(extIds)
+	return libovsdb.Row{Fields: map[string]interface{}{
+		"name":         name,
+		"external_ids": *oMap,
+	}}
+}
@@ -0,0 +1,149 @@
+/**
+ * Copyright (c) 2020 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn
+
+import (
+	"fmt"
+
+	"github.com/ebay/libovsdb"
+)
+
+// LogicalFlowExtIDStageName is the external_ids key holding the stage name
+// of a logical flow, e.g. "ls_in_acl"
+const LogicalFlowExtIDStageName = "stage-name"
+
+// LogicalFlow table OVN SB
+type LogicalFlow struct {
+	UUID            string
+	LogicalDatapath string
+	LogicalDPGroup  string
+	Pipeline        string
+	TableID         int
+	Priority        int
+	Match           string
+	Actions         string
+	ExternalID      map[interface{}]interface{}
+	PopulatedColumns
+}
+
+// List logical flows. Empty datapath, pipeline and stageName and nil tableID
+// match all flows. datapath is resolved like in DatapathBindingGet.
+func (odbi *ovndb) logicalFlowListImp(datapath, pipeline string, tableID *int, stageName string) ([]*LogicalFlow, error) {
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+
+	cacheLogicalFlow, ok := odbi.cache[TableLogicalFlow]
+	if !ok {
+		return nil, ErrorSchema
+	}
+
+	var datapaths map[string]bool
+	if datapath != "" {
+		datapaths = make(map[string]bool)
+		for _, uuid := range odbi.datapathUUIDs(datapath) {
+			datapaths[uuid] = true
+		}
+		if len(datapaths) == 0 {
+			return nil, ErrorNotFound
+		}
+	}
+
+	listLogicalFlow := make([]*LogicalFlow, 0)
+	for uuid := range cacheLogicalFlow {
+		lflow, err := odbi.rowToLogicalFlow(uuid)
+		if err != nil {
+			return nil, err
+		}
+		if pipeline != "" && lflow.Pipeline != pipeline {
+			continue
+		}
+		if tableID != nil && lflow.TableID != *tableID {
+			continue
+		}
+		if stageName != "" && lflow.ExternalID[LogicalFlowExtIDStageName] != stageName {
+			continue
+		}
+		if datapaths != nil && !odbi.logicalFlowOnDatapaths(lflow, datapaths) {
+			continue
+		}
+		listLogicalFlow = append(listLogicalFlow, lflow)
+	}
+	return listLogicalFlow, nil
+}
+
+// logicalFlowOnDatapaths checks whether lflow applies to any of datapaths,
+// either directly or through its Logical_DP_Group.
+func (odbi *ovndb) logicalFlowOnDatapaths(lflow *LogicalFlow, datapaths map[string]bool) bool {
+	if datapaths[lflow.LogicalDatapath] {
+		return true
+	}
+	if lflow.LogicalDPGroup == "" {
+		return false
+	}
+	group, ok := odbi.cache[TableLogicalDPGroup][lflow.LogicalDPGroup]
+	if !ok {
+		return false
+	}
+	switch dps := group.Fields["datapaths"].(type) {
+	case libovsdb.UUID:
+		return datapaths[dps.GoUUID]
+	case libovsdb.OvsSet:
+		for _, dp := range odbi.ConvertGoSetToStringArray(dps) {
+			if datapaths[dp] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (odbi *ovndb) rowToLogicalFlow(uuid string) (*LogicalFlow, error) {
+	cacheLogicalFlow, ok := odbi.cache[TableLogicalFlow][uuid]
+	if !ok {
+		return nil, fmt.Errorf("Logical flow with uuid %s not found", uuid)
+	}
+
+	lflow := &LogicalFlow{
+		UUID: uuid,
+	}
+	if dp, ok := cacheLogicalFlow.Fields["logical_datapath"].(libovsdb.UUID); ok {
+		lflow.LogicalDatapath = dp.GoUUID
+	}
+	if group, ok := cacheLogicalFlow.Fields["logical_dp_group"].(libovsdb.UUID); ok {
+		lflow.LogicalDPGroup = group.GoUUID
+	}
+	if pipeline, ok := cacheLogicalFlow.Fields["pipeline"].(string); ok {
+		lflow.Pipeline = pipeline
+	}
+	if tableID, ok := cacheLogicalFlow.Fields["table_id"].(int); ok {
+		lflow.TableID = tableID
+	}
+	if priority, ok := cacheLogicalFlow.Fields["priority"].(int); ok {
+		lflow.Priority = priority
+	}
+	if match, ok := cacheLogicalFlow.Fields["match"].(string); ok {
+		lflow.Match = match
+	}
+	if actions, ok := cacheLogicalFlow.Fields["actions"].(string); ok {
+		lflow.Actions = actions
+	}
+	if extIds, ok := cacheLogicalFlow.Fields["external_ids"].(libovsdb.OvsMap); ok {
+		lflow.ExternalID = extIds.GoMap
+	}
+	lflow.PopulatedColumns = odbi.populated[TableLogicalFlow]
+	return lflow, nil
+}
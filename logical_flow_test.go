@@ -0,0 +1,142 @@
+/**
+ * Copyright (c) 2020 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn
+
+import (
+	"testing"
+
+	"github.com/ebay/libovsdb"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	LFLOW_LS         = "lflow-ls"
+	LFLOW_LS_UUID    = "0f5c2d4e-6e4b-4c2b-9a7e-3f8d1c2b4a5e"
+	LFLOW_TUNNEL_KEY = 4343
+	LFLOW_STAGE_ACL  = "ls_in_acl"
+	LFLOW_STAGE_L2   = "ls_in_l2_lkup"
+)
+
+func lflowRow(dpUUID, pipeline string, tableID int, stageName, match string) OVNRow {
+	row := make(OVNRow)
+	row["logical_datapath"] = stringToGoUUID(dpUUID)
+	row["pipeline"] = pipeline
+	row["table_id"] = tableID
+	row["priority"] = 100
+	row["match"] = match
+	row["actions"] = "next;"
+	extIds, _ := libovsdb.NewOvsMap(map[string]string{LogicalFlowExtIDStageName: stageName})
+	row["external_ids"] = extIds
+	return row
+}
+
+func TestLogicalFlow(t *testing.T) {
+	cfg := buildOvnDbConfig(DBSB)
+	cfg.TableCols = make(map[string][]string)
+	for _, table := range append(SBTablesOrder, SBOptInTables...) {
+		cfg.TableCols[table] = []string{}
+	}
+	ovndbapi, err := NewClient(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ovndbapi.Close()
+
+	// Datapath_Binding and Logical_Flow rows are normally created by ovn-northd
+	t.Logf("Adding datapath %s with logical flows", LFLOW_LS)
+	dpUUID, err := newRowUUID()
+	if err != nil {
+		t.Fatal(err)
+	}
+	dpRow := make(OVNRow)
+	dpRow["tunnel_key"] = LFLOW_TUNNEL_KEY
+	extIds, err := libovsdb.NewOvsMap(map[string]string{
+		DatapathExtIDName:          LFLOW_LS,
+		DatapathExtIDLogicalSwitch: LFLOW_LS_UUID,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dpRow["external_ids"] = extIds
+	operations := []libovsdb.Operation{
+		{Op: opInsert, Table: TableDatapathBinding, Row: dpRow, UUIDName: dpUUID},
+		{Op: opInsert, Table: TableLogicalFlow, Row: lflowRow(dpUUID, "ingress", 6, LFLOW_STAGE_ACL, MATCH)},
+		{Op: opInsert, Table: TableLogicalFlow, Row: lflowRow(dpUUID, "ingress", 6, LFLOW_STAGE_ACL, MATCH_SECOND)},
+		{Op: opInsert, Table: TableLogicalFlow, Row: lflowRow(dpUUID, "ingress", 19, LFLOW_STAGE_L2, "1")},
+		{Op: opInsert, Table: TableLogicalFlow, Row: lflowRow(dpUUID, "egress", 0, "ls_out_pre_lb", "1")},
+	}
+	err = ovndbapi.Execute(&OvnCommand{operations, ovndbapi.(*ovndb), make([][]map[string]interface{}, len(operations))})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dps, err := ovndbapi.DatapathBindingGet(LFLOW_LS)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 1, len(dps))
+	assert.Equal(t, LFLOW_TUNNEL_KEY, dps[0].TunnelKey)
+
+	dpsByUUID, err := ovndbapi.DatapathBindingGet(LFLOW_LS_UUID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, dps, dpsByUUID)
+
+	_, err = ovndbapi.DatapathBindingGet(FAKENOSWITCH)
+	assert.Equal(t, ErrorNotFound, err)
+
+	lflows, err := ovndbapi.LogicalFlowList(LFLOW_LS, "", nil, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 4, len(lflows))
+
+	lflows, err = ovndbapi.LogicalFlowList(LFLOW_LS, "ingress", nil, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 3, len(lflows))
+
+	tableID := 6
+	lflows, err = ovndbapi.LogicalFlowList(LFLOW_LS_UUID, "ingress", &tableID, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 2, len(lflows))
+
+	lflows, err = ovndbapi.LogicalFlowList("", "", nil, LFLOW_STAGE_L2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 1, len(lflows))
+	assert.Equal(t, dps[0].UUID, lflows[0].LogicalDatapath)
+	assert.Equal(t, 19, lflows[0].TableID)
+
+	_, err = ovndbapi.LogicalFlowList(FAKENOSWITCH, "", nil, "")
+	assert.Equal(t, ErrorNotFound, err)
+
+	t.Logf("Deleting datapath %s and its logical flows", LFLOW_LS)
+	operations = []libovsdb.Operation{
+		{Op: opDelete, Table: TableLogicalFlow, Where: []interface{}{libovsdb.NewCondition("logical_datapath", "==", stringToGoUUID(dps[0].UUID))}},
+		{Op: opDelete, Table: TableDatapathBinding, Where: []interface{}{libovsdb.NewCondition("_uuid", "==", stringToGoUUID(dps[0].UUID))}},
+	}
+	err = ovndbapi.Execute(&OvnCommand{operations, ovndbapi.(*ovndb), make([][]map[string]interface{}, len(operations))})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
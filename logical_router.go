@@ -36,6 +36,7 @@ type LogicalRouter struct {
 
 	Options    map[interface{}]interface{}
 	ExternalID map[interface{}]interface{}
+	PopulatedColumns
 }
 
 func (odbi *ovndb) lrAddImp(name string, external_ids map[string]string) (*OvnCommand, error) {
@@ -108,9 +109,9 @@ func (odbi *ovndb) rowToLogicalRouter(uuid string) *LogicalRouter {
 	}
 	lr := &LogicalRouter{
 		UUID:       uuid,
-		Name:       cacheLogicalRouter.Fields["name"].(string),
-		Options:    cacheLogicalRouter.Fields["options"].(libovsdb.OvsMap).GoMap,
-		ExternalID: cacheLogicalRouter.Fields["external_ids"].(libovsdb.OvsMap).GoMap,
+		Name:       rowString(cacheLogicalRouter, "name"),
+		Options:    rowMap(cacheLogicalRouter, "options"),
+		ExternalID: rowMap(cacheLogicalRouter, "external_ids"),
 	}
 
 	if enabled, ok := cacheLogicalRouter.Fields["enabled"]; ok {
@@ -169,6 +170,7 @@ func (odbi *ovndb) rowToLogicalRouter(uuid string) *LogicalRouter {
 		}
 	}
 
+	lr.PopulatedColumns = odbi.populated[TableLogicalRouter]
 	return lr
 }
 
@@ -32,6 +32,7 @@ type LogicalRouterPolicy struct {
 	NextHops   []string
 	Options    map[interface{}]interface{}
 	ExternalID map[interface{}]interface{}
+	PopulatedColumns
 }
 
 func (odbi *ovndb) lrpolicyAddImp(lr string, priority int, match string, action string, nexthop *string, nexthops []string, options map[string]string, external_ids map[string]string) (*OvnCommand, error) {
@@ -236,20 +237,19 @@ func (odbi *ovndb) rowToLogicalRouterPolicy(uuid string) *LogicalRouterPolicy {
 	}
 	lrpolicy := &LogicalRouterPolicy{
 		UUID:       uuid,
-		Priority:   cacheLogicalRouterPolicy.Fields["priority"].(int),
-		Match:      cacheLogicalRouterPolicy.Fields["match"].(string),
-		Action:     cacheLogicalRouterPolicy.Fields["action"].(string),
-		Options:    cacheLogicalRouterPolicy.Fields["options"].(libovsdb.OvsMap).GoMap,
-		ExternalID: cacheLogicalRouterPolicy.Fields["external_ids"].(libovsdb.OvsMap).GoMap,
+		Priority:   rowInt(cacheLogicalRouterPolicy, "priority"),
+		Match:      rowString(cacheLogicalRouterPolicy, "match"),
+		Action:     rowString(cacheLogicalRouterPolicy, "action"),
+		Options:    rowMap(cacheLogicalRouterPolicy, "options"),
+		ExternalID: rowMap(cacheLogicalRouterPolicy, "external_ids"),
 	}
 
 	if nexthop, ok := cacheLogicalRouterPolicy.Fields["nexthop"]; ok {
 		lrpolicy.Nexthop = odbi.optionalStringFieldToPointer(nexthop)
 	}
 
-	for _, n := range cacheLogicalRouterPolicy.Fields["nexthops"].(libovsdb.OvsSet).GoSet {
-		lrpolicy.NextHops = append(lrpolicy.NextHops, n.(string))
-	}
+	lrpolicy.NextHops = rowSet(cacheLogicalRouterPolicy, "nexthops")
+	lrpolicy.PopulatedColumns = odbi.populated[TableLogicalRouterPolicy]
 	return lrpolicy
 }
 
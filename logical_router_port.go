@@ -27,6 +27,7 @@ type LogicalRouterPort struct {
 	UUID           string
 	Name           string
 	GatewayChassis []string
+	HAChassisGroup string
 	Networks       []string
 	MAC            string
 	Enabled        bool
@@ -34,6 +35,7 @@ type LogicalRouterPort struct {
 	Options        map[interface{}]interface{}
 	Peer           string
 	ExternalID     map[interface{}]interface{}
+	PopulatedColumns
 }
 
 func (odbi *ovndb) lrpAddImp(lr string, lrp string, mac string, network []string, peer string, external_ids map[string]string) (*OvnCommand, error) {
@@ -133,9 +135,9 @@ func (odbi *ovndb) lrpDelImp(lr, lrp string) (*OvnCommand, error) {
 func (odbi *ovndb) rowToLogicalRouterPort(uuid string) *LogicalRouterPort {
 	lrp := &LogicalRouterPort{
 		UUID:       uuid,
-		Name:       odbi.cache[TableLogicalRouterPort][uuid].Fields["name"].(string),
-		MAC:        odbi.cache[TableLogicalRouterPort][uuid].Fields["mac"].(string),
-		ExternalID: odbi.cache[TableLogicalRouterPort][uuid].Fields["external_ids"].(libovsdb.OvsMap).GoMap,
+		Name:       rowString(odbi.cache[TableLogicalRouterPort][uuid], "name"),
+		MAC:        rowString(odbi.cache[TableLogicalRouterPort][uuid], "mac"),
+		ExternalID: rowMap(odbi.cache[TableLogicalRouterPort][uuid], "external_ids"),
 	}
 
 	if peer, ok := odbi.cache[TableLogicalRouterPort][uuid].Fields["peer"]; ok {
@@ -164,12 +166,9 @@ func (odbi *ovndb) rowToLogicalRouterPort(uuid string) *LogicalRouterPort {
 		}
 	}
 
-	gateway_chassis := odbi.cache[TableLogicalRouterPort][uuid].Fields["gateway_chassis"]
-	switch gateway_chassis.(type) {
-	case string:
-		lrp.GatewayChassis = []string{gateway_chassis.(string)}
-	case libovsdb.OvsSet:
-		lrp.GatewayChassis = odbi.ConvertGoSetToStringArray(gateway_chassis.(libovsdb.OvsSet))
+	lrp.GatewayChassis = rowSet(odbi.cache[TableLogicalRouterPort][uuid], "gateway_chassis")
+	if hagrp, ok := odbi.cache[TableLogicalRouterPort][uuid].Fields["ha_chassis_group"].(libovsdb.UUID); ok {
+		lrp.HAChassisGroup = hagrp.GoUUID
 	}
 	networks := odbi.cache[TableLogicalRouterPort][uuid].Fields["networks"]
 	switch networks.(type) {
@@ -179,6 +178,7 @@ func (odbi *ovndb) rowToLogicalRouterPort(uuid string) *LogicalRouterPort {
 		lrp.Networks = odbi.ConvertGoSetToStringArray(networks.(libovsdb.OvsSet))
 	}
 
+	lrp.PopulatedColumns = odbi.populated[TableLogicalRouterPort]
 	return lrp
 }
 
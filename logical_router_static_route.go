@@ -30,6 +30,7 @@ type LogicalRouterStaticRoute struct {
 	OutputPort *string
 	Policy     *string
 	ExternalID map[interface{}]interface{}
+	PopulatedColumns
 }
 
 func (odbi *ovndb) lrsrAddImp(lr string, ip_prefix string, nexthop string, output_port *string, policy *string, external_ids map[string]string) (*OvnCommand, error) {
@@ -170,9 +171,9 @@ func (odbi *ovndb) rowToLogicalRouterStaticRoute(uuid string) *LogicalRouterStat
 	}
 	lrsr := &LogicalRouterStaticRoute{
 		UUID:       uuid,
-		IPPrefix:   cacheLogicalRouterStaticRoute.Fields["ip_prefix"].(string),
-		Nexthop:    cacheLogicalRouterStaticRoute.Fields["nexthop"].(string),
-		ExternalID: cacheLogicalRouterStaticRoute.Fields["external_ids"].(libovsdb.OvsMap).GoMap,
+		IPPrefix:   rowString(cacheLogicalRouterStaticRoute, "ip_prefix"),
+		Nexthop:    rowString(cacheLogicalRouterStaticRoute, "nexthop"),
+		ExternalID: rowMap(cacheLogicalRouterStaticRoute, "external_ids"),
 	}
 
 	if policy, ok := cacheLogicalRouterStaticRoute.Fields["policy"]; ok {
@@ -181,6 +182,7 @@ func (odbi *ovndb) rowToLogicalRouterStaticRoute(uuid string) *LogicalRouterStat
 	if outputPort, ok := cacheLogicalRouterStaticRoute.Fields["output_port"]; ok {
 		lrsr.OutputPort = odbi.optionalStringFieldToPointer(outputPort)
 	}
+	lrsr.PopulatedColumns = odbi.populated[TableLogicalRouterStaticRoute]
 	return lrsr
 }
 
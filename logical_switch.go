@@ -33,6 +33,7 @@ type LogicalSwitch struct {
 	DNSRecords   []string
 	OtherConfig  map[interface{}]interface{}
 	ExternalID   map[interface{}]interface{}
+	PopulatedColumns
 }
 
 func (odbi *ovndb) lsAddImp(lsw string) (*OvnCommand, error) {
@@ -78,9 +79,9 @@ func (odbi *ovndb) rowToLogicalSwitch(uuid string) *LogicalSwitch {
 
 	ls := &LogicalSwitch{
 		UUID:        uuid,
-		Name:        cacheLogicalSwitch.Fields["name"].(string),
-		OtherConfig: cacheLogicalSwitch.Fields["other_config"].(libovsdb.OvsMap).GoMap,
-		ExternalID:  cacheLogicalSwitch.Fields["external_ids"].(libovsdb.OvsMap).GoMap,
+		Name:        rowString(cacheLogicalSwitch, "name"),
+		OtherConfig: rowMap(cacheLogicalSwitch, "other_config"),
+		ExternalID:  rowMap(cacheLogicalSwitch, "external_ids"),
 	}
 	if ports, ok := cacheLogicalSwitch.Fields["ports"]; ok {
 		switch ports.(type) {
@@ -123,6 +124,7 @@ func (odbi *ovndb) rowToLogicalSwitch(uuid string) *LogicalSwitch {
 		}
 	}
 
+	ls.PopulatedColumns = odbi.populated[TableLogicalSwitch]
 	return ls
 }
 
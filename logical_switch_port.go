@@ -35,6 +35,7 @@ type LogicalSwitchPort struct {
 	DHCPv4Options    string
 	DHCPv6Options    string
 	ExternalID       map[interface{}]interface{}
+	PopulatedColumns
 }
 
 func (odbi *ovndb) lspAddImp(lsw, lsp string) (*OvnCommand, error) {
@@ -323,9 +324,9 @@ func (odbi *ovndb) lspGetExternalIdsImp(lsp string) (map[string]string, error) {
 func (odbi *ovndb) rowToLogicalPort(uuid string) (*LogicalSwitchPort, error) {
 	lp := &LogicalSwitchPort{
 		UUID:       uuid,
-		Name:       odbi.cache[TableLogicalSwitchPort][uuid].Fields["name"].(string),
-		Type:       odbi.cache[TableLogicalSwitchPort][uuid].Fields["type"].(string),
-		ExternalID: odbi.cache[TableLogicalSwitchPort][uuid].Fields["external_ids"].(libovsdb.OvsMap).GoMap,
+		Name:       rowString(odbi.cache[TableLogicalSwitchPort][uuid], "name"),
+		Type:       rowString(odbi.cache[TableLogicalSwitchPort][uuid], "type"),
+		ExternalID: rowMap(odbi.cache[TableLogicalSwitchPort][uuid], "external_ids"),
 	}
 
 	if dhcpv4, ok := odbi.cache[TableLogicalSwitchPort][uuid].Fields["dhcpv4_options"]; ok {
@@ -382,6 +383,7 @@ func (odbi *ovndb) rowToLogicalPort(uuid string) (*LogicalSwitchPort, error) {
 		}
 	}
 
+	lp.PopulatedColumns = odbi.populated[TableLogicalSwitchPort]
 	return lp, nil
 }
 
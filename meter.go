@@ -13,6 +13,8 @@ type Meter struct {
 	Unit        string                      `json:"unit"`
 	Bands       []string                    `json:"bands"`
 	ExternalIds map[interface{}]interface{} `json:"external_ids"`
+
+	PopulatedColumns `json:"-"`
 }
 
 type MeterBand struct {
@@ -21,6 +23,8 @@ type MeterBand struct {
 	Rate        int                         `json:"rate"`
 	BurstSize   int                         `json:"burst_size"`
 	ExternalIds map[interface{}]interface{} `json:"external_ids"`
+
+	PopulatedColumns `json:"-"`
 }
 
 func (odbi *ovndb) rowToMeter(uuid string) *Meter {
@@ -30,11 +34,12 @@ func (odbi *ovndb) rowToMeter(uuid string) *Meter {
 	}
 	meter := &Meter{
 		UUID:        uuid,
-		Name:        cacheMeter.Fields["name"].(string),
-		Unit:        cacheMeter.Fields["unit"].(string),
-		Bands:       []string{cacheMeter.Fields["bands"].(libovsdb.UUID).GoUUID},
-		ExternalIds: cacheMeter.Fields["external_ids"].(libovsdb.OvsMap).GoMap,
+		Name:        rowString(cacheMeter, "name"),
+		Unit:        rowString(cacheMeter, "unit"),
+		Bands:       rowSet(cacheMeter, "bands"),
+		ExternalIds: rowMap(cacheMeter, "external_ids"),
 	}
+	meter.PopulatedColumns = odbi.populated[TableMeter]
 	return meter
 }
 
@@ -45,11 +50,12 @@ func (odbi *ovndb) rowToMeterBand(uuid string) (*MeterBand, error) {
 	}
 	meterBand := &MeterBand{
 		UUID:        uuid,
-		Action:      cacheMeterBand.Fields["action"].(string),
-		Rate:        cacheMeterBand.Fields["rate"].(int),
-		BurstSize:   cacheMeterBand.Fields["burst_size"].(int),
-		ExternalIds: cacheMeterBand.Fields["external_ids"].(libovsdb.OvsMap).GoMap,
+		Action:      rowString(cacheMeterBand, "action"),
+		Rate:        rowInt(cacheMeterBand, "rate"),
+		BurstSize:   rowInt(cacheMeterBand, "burst_size"),
+		ExternalIds: rowMap(cacheMeterBand, "external_ids"),
 	}
+	meterBand.PopulatedColumns = odbi.populated[TableMeterBand]
 	return meterBand, nil
 }
 
@@ -145,7 +151,7 @@ func (odbi *ovndb) meterDelImp(name ...string) (*OvnCommand, error) {
 	switch len(name) {
 	case 0:
 		for uuid := range odbi.cache[TableMeter] {
-			name := odbi.cache[TableMeter][uuid].Fields["name"].(string)
+			name := rowString(odbi.cache[TableMeter][uuid], "name")
 			operations, err = odbi.singleMeterDel(name, operations)
 			if err != nil {
 				return nil, err
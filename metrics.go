@@ -0,0 +1,85 @@
+/**
+ * Copyright (c) 2020 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn
+
+import (
+	"context"
+	"time"
+
+	"github.com/ebay/libovsdb"
+)
+
+// Outcomes of transact requests passed to Metrics.TransactDone
+const (
+	TransactOutcomeSuccess = "success"
+	TransactOutcomeFailed  = "failed"  // an operation or the commit was rejected by the server
+	TransactOutcomeTimeout = "timeout" // the context was done before the reply
+	TransactOutcomeError   = "error"   // the request could not be sent or answered
+)
+
+// Metrics receives the measurements of a client set in Config.Metrics. The
+// prommetrics package implements it for Prometheus. Methods are called
+// concurrently and must not block.
+type Metrics interface {
+	// TransactDone is called once for each table and op in a transact
+	// request, with the latency of the request
+	TransactDone(db, table, op, outcome string, duration time.Duration)
+	// CacheRows is called with the number of cached rows of table after
+	// each update of the table
+	CacheRows(db, table string, rows int)
+	// UpdateReceived is called for each table in an update notification of
+	// the db server
+	UpdateReceived(db, table string, rows int)
+	// ReconnectAttempt is called after each automatic reconnection attempt
+	ReconnectAttempt(db string, success bool)
+}
+
+// transactOutcome classifies the result of a transact request
+func transactOutcome(reply []libovsdb.OperationResult, err error) string {
+	switch err {
+	case nil:
+	case context.DeadlineExceeded, context.Canceled:
+		return TransactOutcomeTimeout
+	default:
+		return TransactOutcomeError
+	}
+	for _, r := range reply {
+		if r.Error != "" {
+			return TransactOutcomeFailed
+		}
+	}
+	return TransactOutcomeSuccess
+}
+
+// observeTransact reports a transact request to the metrics of the client
+func (odbi *ovndb) observeTransact(db string, ops []libovsdb.Operation, outcome string, duration time.Duration) {
+	if odbi.metrics == nil {
+		return
+	}
+	type tableOp struct {
+		table, op string
+	}
+	seen := make(map[tableOp]bool)
+	for _, op := range ops {
+		key := tableOp{op.Table, op.Op}
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		odbi.metrics.TransactDone(db, op.Table, op.Op, outcome, duration)
+	}
+}
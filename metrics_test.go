@@ -0,0 +1,79 @@
+/**
+ * Copyright (c) 2020 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ebay/libovsdb"
+	"github.com/stretchr/testify/assert"
+)
+
+type testMetrics struct {
+	transacts map[string]string
+	cacheRows map[string]int
+}
+
+func (m *testMetrics) TransactDone(db, table, op, outcome string, duration time.Duration) {
+	m.transacts[table+"/"+op] = outcome
+}
+
+func (m *testMetrics) CacheRows(db, table string, rows int) {
+	m.cacheRows[table] = rows
+}
+
+func (m *testMetrics) UpdateReceived(db, table string, rows int) {}
+
+func (m *testMetrics) ReconnectAttempt(db string, success bool) {}
+
+func TestTransactOutcome(t *testing.T) {
+	assert.Equal(t, TransactOutcomeSuccess, transactOutcome([]libovsdb.OperationResult{{}}, nil))
+	assert.Equal(t, TransactOutcomeFailed, transactOutcome([]libovsdb.OperationResult{{}, {Error: TxnErrorConstraintViolation}}, nil))
+	assert.Equal(t, TransactOutcomeTimeout, transactOutcome(nil, context.DeadlineExceeded))
+	assert.Equal(t, TransactOutcomeError, transactOutcome(nil, errors.New("connection closed")))
+}
+
+func TestMetricsHook(t *testing.T) {
+	m := &testMetrics{transacts: make(map[string]string), cacheRows: make(map[string]int)}
+	odbi := &ovndb{
+		ovndbConfig: ovndbConfig{
+			tableCols: map[string][]string{TableLogicalSwitchPort: {}},
+			metrics:   m,
+		},
+		cache: make(map[string]map[string]libovsdb.Row),
+	}
+	odbi.populateCache(libovsdb.TableUpdates{Updates: map[string]libovsdb.TableUpdate{
+		TableLogicalSwitchPort: {Rows: map[string]libovsdb.RowUpdate{
+			"lsp1": {New: lspRow("lsp1", map[string]string{FOO: BAR})},
+			"lsp2": {New: lspRow("lsp2", map[string]string{FOO: BAR})},
+		}},
+	}})
+	assert.Equal(t, 2, m.cacheRows[TableLogicalSwitchPort])
+
+	odbi.observeTransact(DBNB, []libovsdb.Operation{
+		{Op: opInsert, Table: TableLogicalSwitchPort},
+		{Op: opInsert, Table: TableLogicalSwitchPort},
+		{Op: opMutate, Table: TableLogicalSwitch},
+	}, TransactOutcomeSuccess, time.Millisecond)
+	assert.Equal(t, map[string]string{
+		TableLogicalSwitchPort + "/" + opInsert: TransactOutcomeSuccess,
+		TableLogicalSwitch + "/" + opMutate:     TransactOutcomeSuccess,
+	}, m.transacts)
+}
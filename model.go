@@ -0,0 +1,545 @@
+/**
+ * Copyright (c) 2020 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/ebay/libovsdb"
+)
+
+// modelTag is the struct tag that maps a field of a model to a column, the
+// row UUID is mapped with `ovsdb:"_uuid"`
+const modelTag = "ovsdb"
+
+const uuidColumn = "_uuid"
+
+type modelField struct {
+	column string
+	index  []int
+}
+
+// modelInfo is the parsed form of a model struct type
+type modelInfo struct {
+	typ    reflect.Type
+	uuid   []int // index of the _uuid field, nil if there is none
+	fields []modelField
+}
+
+// modelInfos caches the modelInfo of each struct type
+var modelInfos sync.Map
+
+// getModelInfo parses the ovsdb tags of the struct type t
+func getModelInfo(t reflect.Type) (*modelInfo, error) {
+	if info, ok := modelInfos.Load(t); ok {
+		return info.(*modelInfo), nil
+	}
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("model %v is not a struct", t)
+	}
+	info := &modelInfo{typ: t}
+	seen := make(map[string]bool)
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		column := f.Tag.Get(modelTag)
+		if column == "" || column == "-" {
+			continue
+		}
+		if seen[column] {
+			return nil, fmt.Errorf("model %v maps column %s twice", t, column)
+		}
+		seen[column] = true
+		if column == uuidColumn {
+			if f.Type.Kind() != reflect.String {
+				return nil, fmt.Errorf("model %v field %s of %s must be a string", t, f.Name, uuidColumn)
+			}
+			info.uuid = f.Index
+			continue
+		}
+		info.fields = append(info.fields, modelField{column, f.Index})
+	}
+	modelInfos.Store(t, info)
+	return info, nil
+}
+
+// modelValue returns the struct value model points to and its modelInfo
+func modelValue(model interface{}) (reflect.Value, *modelInfo, error) {
+	v := reflect.ValueOf(model)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return reflect.Value{}, nil, fmt.Errorf("model %T is not a non-nil struct pointer", model)
+	}
+	info, err := getModelInfo(v.Elem().Type())
+	if err != nil {
+		return reflect.Value{}, nil, err
+	}
+	return v.Elem(), info, nil
+}
+
+// validate checks that the fields of the model can hold the columns of table
+func (info *modelInfo) validate(schema libovsdb.DatabaseSchema, table string) error {
+	tableSchema, ok := schema.Tables[table]
+	if !ok {
+		return fmt.Errorf("model %v: table %s not found in schema", info.typ, table)
+	}
+	for _, f := range info.fields {
+		column, ok := tableSchema.Columns[f.column]
+		if !ok {
+			return fmt.Errorf("model %v: column %s not found in table %s", info.typ, f.column, table)
+		}
+		field := info.typ.FieldByIndex(f.index)
+		if !fieldFitsColumn(field.Type, parseColumnType(column.Type)) {
+			return fmt.Errorf("model %v: field %s of type %v cannot hold column %s of table %s",
+				info.typ, field.Name, field.Type, f.column, table)
+		}
+	}
+	return nil
+}
+
+// atomicFits reports whether values of the atomic type can be stored in t
+func atomicFits(t reflect.Type, atomic string) bool {
+	switch t.Kind() {
+	case reflect.Interface:
+		return t.NumMethod() == 0
+	case reflect.String:
+		return atomic == atomicString || atomic == atomicUUID
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return atomic == atomicInteger
+	case reflect.Float32, reflect.Float64:
+		return atomic == atomicReal
+	case reflect.Bool:
+		return atomic == atomicBoolean
+	}
+	return false
+}
+
+func fieldFitsColumn(t reflect.Type, ct columnType) bool {
+	switch {
+	case ct.isMap():
+		return t.Kind() == reflect.Map && atomicFits(t.Key(), ct.key) && atomicFits(t.Elem(), ct.value)
+	case t.Kind() == reflect.Slice:
+		return atomicFits(t.Elem(), ct.key)
+	case t.Kind() == reflect.Ptr:
+		// an optional value
+		return ct.min == 0 && ct.max == 1 && atomicFits(t.Elem(), ct.key)
+	case ct.isSet() && ct.max != 1:
+		return false
+	}
+	// a scalar, or an optional value that is the zero value when empty
+	return atomicFits(t, ct.key)
+}
+
+// decodeAtomic stores the atomic ovsdb value in v
+func decodeAtomic(value interface{}, v reflect.Value) error {
+	if u, ok := value.(libovsdb.UUID); ok {
+		value = u.GoUUID
+	}
+	if v.Kind() == reflect.Interface {
+		v.Set(reflect.ValueOf(value))
+		return nil
+	}
+	switch x := value.(type) {
+	case string:
+		if v.Kind() == reflect.String {
+			v.SetString(x)
+			return nil
+		}
+	case bool:
+		if v.Kind() == reflect.Bool {
+			v.SetBool(x)
+			return nil
+		}
+	case int:
+		switch v.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			v.SetInt(int64(x))
+			return nil
+		case reflect.Float32, reflect.Float64:
+			v.SetFloat(float64(x))
+			return nil
+		}
+	case float64:
+		switch v.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			v.SetInt(int64(x))
+			return nil
+		case reflect.Float32, reflect.Float64:
+			v.SetFloat(x)
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot store %v (%T) in %v", value, value, v.Type())
+}
+
+// decodeValue stores the value of a column in the field v
+func decodeValue(value interface{}, v reflect.Value) error {
+	var elems []interface{}
+	isSet := false
+	switch x := value.(type) {
+	case libovsdb.OvsMap:
+		if v.Kind() != reflect.Map {
+			return fmt.Errorf("cannot store a map in %v", v.Type())
+		}
+		m := reflect.MakeMapWithSize(v.Type(), len(x.GoMap))
+		for key, val := range x.GoMap {
+			k := reflect.New(v.Type().Key()).Elem()
+			if err := decodeAtomic(key, k); err != nil {
+				return err
+			}
+			e := reflect.New(v.Type().Elem()).Elem()
+			if err := decodeAtomic(val, e); err != nil {
+				return err
+			}
+			m.SetMapIndex(k, e)
+		}
+		v.Set(m)
+		return nil
+	case libovsdb.OvsSet:
+		elems = x.GoSet
+		isSet = true
+	default:
+		elems = []interface{}{value}
+	}
+
+	switch v.Kind() {
+	case reflect.Slice:
+		s := reflect.MakeSlice(v.Type(), len(elems), len(elems))
+		for i, elem := range elems {
+			if err := decodeAtomic(elem, s.Index(i)); err != nil {
+				return err
+			}
+		}
+		v.Set(s)
+		return nil
+	case reflect.Ptr:
+		if len(elems) == 0 {
+			v.Set(reflect.Zero(v.Type()))
+			return nil
+		}
+		p := reflect.New(v.Type().Elem())
+		if err := decodeAtomic(elems[0], p.Elem()); err != nil {
+			return err
+		}
+		v.Set(p)
+		return nil
+	}
+	if len(elems) == 0 {
+		v.Set(reflect.Zero(v.Type()))
+		return nil
+	}
+	if isSet && len(elems) > 1 {
+		return fmt.Errorf("cannot store a set of %d values in %v", len(elems), v.Type())
+	}
+	return decodeAtomic(elems[0], v)
+}
+
+// rowToModel fills the struct model points to from row. Columns missing from
+// row, e.g. columns that are not monitored, are left untouched.
+func rowToModel(row libovsdb.Row, uuid string, model interface{}) error {
+	v, info, err := modelValue(model)
+	if err != nil {
+		return err
+	}
+	if info.uuid != nil {
+		v.FieldByIndex(info.uuid).SetString(uuid)
+	}
+	for _, f := range info.fields {
+		value, ok := row.Fields[f.column]
+		if !ok {
+			continue
+		}
+		if err := decodeValue(value, v.FieldByIndex(f.index)); err != nil {
+			return fmt.Errorf("column %s: %v", f.column, err)
+		}
+	}
+	return nil
+}
+
+// encodeAtomic returns the ovsdb value of v for the atomic type
+func encodeAtomic(v reflect.Value, atomic string) interface{} {
+	if v.Kind() == reflect.Interface {
+		v = v.Elem()
+		if !v.IsValid() {
+			return nil
+		}
+	}
+	switch v.Kind() {
+	case reflect.String:
+		if atomic == atomicUUID {
+			return stringToGoUUID(v.String())
+		}
+		return v.String()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return int(v.Int())
+	case reflect.Float32, reflect.Float64:
+		return v.Float()
+	}
+	return v.Interface()
+}
+
+// emptyMap is the value of an empty map column, libovsdb.OvsMap marshals an
+// empty map as ["map",null] which the db server rejects
+var emptyMap = json.RawMessage(`["map",[]]`)
+
+// encodeValue returns the ovsdb value of the field v for a column of type ct
+func encodeValue(v reflect.Value, ct columnType) interface{} {
+	switch v.Kind() {
+	case reflect.Map:
+		if v.Len() == 0 {
+			return emptyMap
+		}
+		m := make(map[interface{}]interface{}, v.Len())
+		for _, k := range v.MapKeys() {
+			m[encodeAtomic(k, ct.key)] = encodeAtomic(v.MapIndex(k), ct.value)
+		}
+		return libovsdb.OvsMap{GoMap: m}
+	case reflect.Slice:
+		elems := make([]interface{}, 0, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			elems = append(elems, encodeAtomic(v.Index(i), ct.key))
+		}
+		return libovsdb.OvsSet{GoSet: elems}
+	case reflect.Ptr:
+		if v.IsNil() {
+			return libovsdb.OvsSet{GoSet: []interface{}{}}
+		}
+		return encodeAtomic(v.Elem(), ct.key)
+	}
+	if ct.min == 0 && ct.max == 1 && v.Kind() != reflect.Interface && v.Interface() == reflect.Zero(v.Type()).Interface() {
+		// the zero value of an optional column is stored as an empty set
+		return libovsdb.OvsSet{GoSet: []interface{}{}}
+	}
+	return encodeAtomic(v, ct.key)
+}
+
+// modelToRow returns the row of model for table, only with the given columns
+// if any. Columns are typed with the schema of the client.
+func (odbi *ovndb) modelToRow(table string, model interface{}, columns ...string) (OVNRow, error) {
+	v, info, err := modelValue(model)
+	if err != nil {
+		return nil, err
+	}
+	columnTypes := odbi.tableColumnTypes(table)
+	if columnTypes == nil {
+		return nil, ErrorSchema
+	}
+	wanted := make(map[string]bool, len(columns))
+	for _, column := range columns {
+		wanted[column] = true
+	}
+	row := make(OVNRow)
+	for _, f := range info.fields {
+		if len(wanted) > 0 && !wanted[f.column] {
+			continue
+		}
+		ct, ok := columnTypes[f.column]
+		if !ok {
+			return nil, fmt.Errorf("model %v: column %s not found in table %s", info.typ, f.column, table)
+		}
+		row[f.column] = encodeValue(v.FieldByIndex(f.index), ct)
+		delete(wanted, f.column)
+	}
+	if len(wanted) > 0 {
+		var missing []string
+		for column := range wanted {
+			missing = append(missing, column)
+		}
+		sort.Strings(missing)
+		return nil, fmt.Errorf("model %v has no field for columns %s", info.typ, strings.Join(missing, ", "))
+	}
+	return row, nil
+}
+
+// registerModels validates the models of Config.Models against the schema
+// of the client
+func (odbi *ovndb) registerModels() error {
+	odbi.models = make(map[reflect.Type]string, len(odbi.modelDefs))
+	schema := odbi.GetSchema()
+	for table, model := range odbi.modelDefs {
+		t := reflect.TypeOf(model)
+		if t != nil && t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+		if t == nil {
+			return fmt.Errorf("model of table %s is nil", table)
+		}
+		info, err := getModelInfo(t)
+		if err != nil {
+			return err
+		}
+		if err := info.validate(schema, table); err != nil {
+			return err
+		}
+		if _, ok := odbi.tableCols[table]; !ok {
+			return fmt.Errorf("model %v: table %s is not monitored", t, table)
+		}
+		if other, ok := odbi.models[t]; ok {
+			return fmt.Errorf("model %v is registered for tables %s and %s", t, other, table)
+		}
+		odbi.models[t] = table
+	}
+	return nil
+}
+
+// modelTable returns the table model is registered for
+func (odbi *ovndb) modelTable(model interface{}) (string, error) {
+	t := reflect.TypeOf(model)
+	for t != nil && (t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice) {
+		t = t.Elem()
+	}
+	table, ok := odbi.models[t]
+	if !ok {
+		return "", fmt.Errorf("model %v is not registered in Config.Models", t)
+	}
+	return table, nil
+}
+
+func (odbi *ovndb) modelUUID(model interface{}) (string, error) {
+	v, info, err := modelValue(model)
+	if err != nil {
+		return "", err
+	}
+	if info.uuid == nil {
+		return "", fmt.Errorf("model %v has no %s field", info.typ, uuidColumn)
+	}
+	uuid := v.FieldByIndex(info.uuid).String()
+	if uuid == "" {
+		return "", ErrorNotFound
+	}
+	return uuid, nil
+}
+
+func (odbi *ovndb) modelGetImp(uuid string, model interface{}) error {
+	table, err := odbi.modelTable(model)
+	if err != nil {
+		return err
+	}
+
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+
+	row, ok := odbi.cache[table][uuid]
+	if !ok {
+		return ErrorNotFound
+	}
+	return rowToModel(row, uuid, model)
+}
+
+// modelListImp fills result, a pointer to a slice of model struct pointers,
+// with all cached rows of the table of the model
+func (odbi *ovndb) modelListImp(result interface{}) error {
+	rv := reflect.ValueOf(result)
+	if rv.Kind() != reflect.Ptr || rv.Elem().Kind() != reflect.Slice ||
+		rv.Elem().Type().Elem().Kind() != reflect.Ptr {
+		return fmt.Errorf("result %T is not a pointer to a slice of struct pointers", result)
+	}
+	table, err := odbi.modelTable(result)
+	if err != nil {
+		return err
+	}
+	elemType := rv.Elem().Type().Elem().Elem()
+
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+
+	cacheTable, ok := odbi.cache[table]
+	if !ok {
+		return ErrorSchema
+	}
+	list := reflect.MakeSlice(rv.Elem().Type(), 0, len(cacheTable))
+	for uuid, row := range cacheTable {
+		model := reflect.New(elemType)
+		if err := rowToModel(row, uuid, model.Interface()); err != nil {
+			return err
+		}
+		list = reflect.Append(list, model)
+	}
+	rv.Elem().Set(list)
+	return nil
+}
+
+// Insert the row of model, its _uuid field is ignored. The UUID of the row
+// is returned by ExecuteR.
+func (odbi *ovndb) modelAddImp(model interface{}) (*OvnCommand, error) {
+	table, err := odbi.modelTable(model)
+	if err != nil {
+		return nil, err
+	}
+	row, err := odbi.modelToRow(table, model)
+	if err != nil {
+		return nil, err
+	}
+	namedUUID, err := newRowUUID()
+	if err != nil {
+		return nil, err
+	}
+	insertOp := libovsdb.Operation{
+		Op:       opInsert,
+		Table:    table,
+		Row:      row,
+		UUIDName: namedUUID,
+	}
+	operations := []libovsdb.Operation{insertOp}
+	return &OvnCommand{operations, odbi, make([][]map[string]interface{}, len(operations))}, nil
+}
+
+// Update the row with the _uuid of model, all mapped columns if none given
+func (odbi *ovndb) modelUpdateImp(model interface{}, columns ...string) (*OvnCommand, error) {
+	table, err := odbi.modelTable(model)
+	if err != nil {
+		return nil, err
+	}
+	uuid, err := odbi.modelUUID(model)
+	if err != nil {
+		return nil, err
+	}
+	row, err := odbi.modelToRow(table, model, columns...)
+	if err != nil {
+		return nil, err
+	}
+	updateOp := libovsdb.Operation{
+		Op:    opUpdate,
+		Table: table,
+		Row:   row,
+		Where: []interface{}{libovsdb.NewCondition(uuidColumn, "==", stringToGoUUID(uuid))},
+	}
+	operations := []libovsdb.Operation{updateOp}
+	return &OvnCommand{operations, odbi, make([][]map[string]interface{}, len(operations))}, nil
+}
+
+// Delete the row with the _uuid of model
+func (odbi *ovndb) modelDelImp(model interface{}) (*OvnCommand, error) {
+	table, err := odbi.modelTable(model)
+	if err != nil {
+		return nil, err
+	}
+	uuid, err := odbi.modelUUID(model)
+	if err != nil {
+		return nil, err
+	}
+	deleteOp := libovsdb.Operation{
+		Op:    opDelete,
+		Table: table,
+		Where: []interface{}{libovsdb.NewCondition(uuidColumn, "==", stringToGoUUID(uuid))},
+	}
+	operations := []libovsdb.Operation{deleteOp}
+	return &OvnCommand{operations, odbi, make([][]map[string]interface{}, len(operations))}, nil
+}
@@ -0,0 +1,102 @@
+/**
+ * Copyright (c) 2020 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package nb_test
+
+import (
+	"testing"
+	"time"
+
+	goovn "github.com/ebay/go-ovn"
+	"github.com/ebay/go-ovn/goovntest"
+	"github.com/ebay/go-ovn/model/nb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestModels(t *testing.T) {
+	c := goovntest.NewClientWithConfig(t, &goovn.Config{Db: goovn.DBNB, Models: nb.Models()})
+	assert.Equal(t, nb.SchemaVersion, c.GetSchema().Version)
+
+	cmd, err := nb.CreateLogicalSwitch(c, &nb.LogicalSwitch{Name: "ls1", ExternalIDs: map[string]string{"foo": "bar"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Execute(cmd); err != nil {
+		t.Fatal(err)
+	}
+	lss, err := nb.ListLogicalSwitch(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !assert.Len(t, lss, 1) {
+		return
+	}
+	assert.Equal(t, "ls1", lss[0].Name)
+	assert.Equal(t, map[string]string{"foo": "bar"}, lss[0].ExternalIDs)
+
+	lss[0].ExternalIDs["foo"] = "baz"
+	if cmd, err = nb.UpdateLogicalSwitch(c, lss[0], "external_ids"); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Execute(cmd); err != nil {
+		t.Fatal(err)
+	}
+	ls, err := nb.GetLogicalSwitch(c, lss[0].UUID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "baz", ls.ExternalIDs["foo"])
+
+	if cmd, err = nb.DeleteLogicalSwitch(c, ls); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Execute(cmd); err != nil {
+		t.Fatal(err)
+	}
+	_, err = nb.GetLogicalSwitch(c, ls.UUID)
+	assert.Equal(t, goovn.ErrorNotFound, err)
+}
+
+func TestModelsInTransaction(t *testing.T) {
+	c := goovntest.NewClientWithConfig(t, &goovn.Config{Db: goovn.DBNB, Models: nb.Models()})
+
+	txn := c.NewTransaction()
+	err := txn.Add(func(c goovn.Client) (*goovn.OvnCommand, error) {
+		return nb.CreateLogicalSwitch(c, &nb.LogicalSwitch{Name: "ls1"})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the models are read from the view of the transaction
+	err = txn.Add(func(c goovn.Client) (*goovn.OvnCommand, error) {
+		lss, err := nb.ListLogicalSwitch(c)
+		if err != nil {
+			return nil, err
+		}
+		assert.Len(t, lss, 1)
+		return nil, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = txn.Commit(); err != nil {
+		t.Fatal(err)
+	}
+	assert.Eventually(t, func() bool {
+		lss, err := nb.ListLogicalSwitch(c)
+		return err == nil && len(lss) == 1 && lss[0].Name == "ls1"
+	}, 5*time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,213 @@
+/**
+ * Copyright (c) 2020 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/ebay/libovsdb"
+	"github.com/stretchr/testify/assert"
+)
+
+type testSwitch struct {
+	UUID        string            `ovsdb:"_uuid"`
+	Name        string            `ovsdb:"name"`
+	Ports       []string          `ovsdb:"ports"`
+	ExternalIDs map[string]string `ovsdb:"external_ids"`
+	Ignored     string
+}
+
+type testPort struct {
+	UUID          string   `ovsdb:"_uuid"`
+	Name          string   `ovsdb:"name"`
+	Tag           *int     `ovsdb:"tag"`
+	DHCPv4Options string   `ovsdb:"dhcpv4_options"`
+	Addresses     []string `ovsdb:"addresses"`
+	Enabled       *bool    `ovsdb:"enabled"`
+}
+
+func TestRowToModel(t *testing.T) {
+	extIds, _ := libovsdb.NewOvsMap(map[string]string{FOO: BAR})
+	row := libovsdb.Row{Fields: map[string]interface{}{
+		"name":           LSP,
+		"tag":            libovsdb.OvsSet{GoSet: []interface{}{}},
+		"dhcpv4_options": libovsdb.UUID{GoUUID: "7a9f2a6c-24f5-4a5e-8ef3-3bd6e6a3e6a1"},
+		"addresses":      ADDR,
+		"enabled":        true,
+		"external_ids":   *extIds,
+	}}
+	port := &testPort{}
+	err := rowToModel(row, "uuid1", port)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "uuid1", port.UUID)
+	assert.Equal(t, LSP, port.Name)
+	assert.Nil(t, port.Tag)
+	assert.Equal(t, "7a9f2a6c-24f5-4a5e-8ef3-3bd6e6a3e6a1", port.DHCPv4Options)
+	assert.Equal(t, []string{ADDR}, port.Addresses)
+	if assert.NotNil(t, port.Enabled) {
+		assert.True(t, *port.Enabled)
+	}
+
+	row.Fields["tag"] = 100
+	err = rowToModel(row, "uuid1", port)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if assert.NotNil(t, port.Tag) {
+		assert.Equal(t, 100, *port.Tag)
+	}
+
+	sw := &testSwitch{}
+	err = rowToModel(libovsdb.Row{Fields: map[string]interface{}{
+		"name":         LSW,
+		"ports":        libovsdb.OvsSet{GoSet: []interface{}{libovsdb.UUID{GoUUID: "p1"}, libovsdb.UUID{GoUUID: "p2"}}},
+		"external_ids": *extIds,
+	}}, "uuid2", sw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []string{"p1", "p2"}, sw.Ports)
+	assert.Equal(t, map[string]string{FOO: BAR}, sw.ExternalIDs)
+
+	assert.Error(t, rowToModel(libovsdb.Row{Fields: map[string]interface{}{"name": 1}}, "uuid3", sw))
+	assert.Error(t, rowToModel(row, "uuid1", testPort{}))
+}
+
+func TestModelValidate(t *testing.T) {
+	schema := libovsdb.DatabaseSchema{Tables: map[string]libovsdb.TableSchema{
+		TableLogicalSwitch: {Columns: map[string]libovsdb.ColumnSchema{
+			"name": {Type: "string"},
+			"ports": {Type: map[string]interface{}{
+				"key": map[string]interface{}{"type": "uuid", "refTable": TableLogicalSwitchPort},
+				"min": float64(0), "max": "unlimited",
+			}},
+			"external_ids": {Type: map[string]interface{}{
+				"key": "string", "value": "string", "min": float64(0), "max": "unlimited",
+			}},
+		}},
+	}}
+	info, err := getModelInfo(reflect.TypeOf(testSwitch{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Nil(t, info.validate(schema, TableLogicalSwitch))
+	assert.Error(t, info.validate(schema, TableLogicalSwitchPort))
+
+	type badSwitch struct {
+		Name  string `ovsdb:"name"`
+		Ports string `ovsdb:"ports"`
+	}
+	info, err = getModelInfo(reflect.TypeOf(badSwitch{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Error(t, info.validate(schema, TableLogicalSwitch))
+
+	ports := parseColumnType(schema.Tables[TableLogicalSwitch].Columns["ports"].Type)
+	assert.Equal(t, libovsdb.OvsSet{GoSet: []interface{}{libovsdb.UUID{GoUUID: "p1"}}},
+		encodeValue(reflect.ValueOf([]string{"p1"}), ports))
+}
+
+func TestModelAPI(t *testing.T) {
+	cfg := buildOvnDbConfig(DBNB)
+	cfg.Models = map[string]interface{}{
+		TableLogicalSwitch: &testSwitch{},
+	}
+	ovndbapi, err := NewClient(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ovndbapi.Close()
+
+	cmd, err := ovndbapi.ModelAdd(&testSwitch{Name: LSW, ExternalIDs: map[string]string{FOO: BAR}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	uuids, err := ovndbapi.ExecuteR(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sw := &testSwitch{}
+	err = ovndbapi.ModelGet(uuids[0], sw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, LSW, sw.Name)
+	assert.Equal(t, BAR, sw.ExternalIDs[FOO])
+
+	sw.ExternalIDs[FOO] = DUMMY
+	cmd, err = ovndbapi.ModelUpdate(sw, "external_ids")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var switches []*testSwitch
+	err = ovndbapi.ModelList(&switches)
+	if err != nil {
+		t.Fatal(err)
+	}
+	found := false
+	for _, s := range switches {
+		if s.UUID == sw.UUID {
+			found = true
+			assert.Equal(t, DUMMY, s.ExternalIDs[FOO])
+		}
+	}
+	assert.True(t, found)
+
+	_, err = ovndbapi.ModelAdd(&testPort{Name: LSP})
+	assert.Error(t, err)
+
+	cmd, err = ovndbapi.ModelDel(sw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.ModelGet(sw.UUID, &testSwitch{})
+	assert.Equal(t, ErrorNotFound, err)
+}
+
+func TestLRNatListSkipsMissing(t *testing.T) {
+	odbi := &ovndb{cache: map[string]map[string]libovsdb.Row{
+		TableLogicalRouter: {"lr1": {Fields: map[string]interface{}{
+			"name": LR,
+			"nat":  libovsdb.OvsSet{GoSet: []interface{}{libovsdb.UUID{GoUUID: "nat1"}, libovsdb.UUID{GoUUID: "nat2"}}},
+		}}},
+		TableNAT: {"nat1": {Fields: map[string]interface{}{
+			"type":        "snat",
+			"external_ip": "10.0.0.1",
+			"logical_ip":  "192.168.0.0/24",
+		}}},
+	}}
+	nats, err := odbi.LRNATList(LR)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if assert.Equal(t, 1, len(nats)) {
+		assert.Equal(t, "nat1", nats[0].UUID)
+		assert.Equal(t, "10.0.0.1", nats[0].ExternalIP)
+	}
+}
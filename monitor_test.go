@@ -0,0 +1,71 @@
+/**
+ * Copyright (c) 2020 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn_test
+
+import (
+	"testing"
+	"time"
+
+	goovn "github.com/ebay/go-ovn"
+	"github.com/ebay/go-ovn/goovntest"
+	"github.com/ebay/libovsdb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSBOptInTables(t *testing.T) {
+	cfg := &goovn.Config{Db: goovn.DBSB, TableCols: make(map[string][]string)}
+	for _, table := range append(goovn.SBTablesOrder, goovn.SBOptInTables...) {
+		cfg.TableCols[table] = []string{}
+	}
+	optIn := goovntest.NewClientWithConfig(t, cfg)
+	// Logical flows are not cached by default
+	c, err := goovn.NewClient(&goovn.Config{Db: goovn.DBSB, Addr: cfg.Addr})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	execute(t, c,
+		libovsdb.Operation{Op: "insert", Table: goovn.TableDatapathBinding, UUIDName: "dp",
+			Row: map[string]interface{}{"tunnel_key": 1}},
+		libovsdb.Operation{Op: "insert", Table: goovn.TableLogicalFlow, Row: map[string]interface{}{
+			"logical_datapath": libovsdb.UUID{GoUUID: "dp"}, "pipeline": "ingress", "table_id": 0,
+			"priority": 100, "match": "1", "actions": "next;"}},
+		// A Port_Binding keeps the Datapath_Binding
+		libovsdb.Operation{Op: "insert", Table: goovn.TablePortBinding, Row: map[string]interface{}{
+			"logical_port": "lp1", "tunnel_key": 1, "datapath": libovsdb.UUID{GoUUID: "dp"}}},
+	)
+
+	_, err = c.LogicalFlowList("", "", nil, "")
+	assert.Equal(t, goovn.ErrorSchema, err)
+	_, err = c.DatapathBindingList()
+	assert.Equal(t, goovn.ErrorSchema, err)
+
+	var lflows []*goovn.LogicalFlow
+	assert.Eventually(t, func() bool {
+		lflows, err = optIn.LogicalFlowList("", "ingress", nil, "")
+		return err == nil && len(lflows) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	if assert.Len(t, lflows, 1) {
+		assert.Equal(t, "1", lflows[0].Match)
+	}
+	dps, err := optIn.DatapathBindingList()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Len(t, dps, 1)
+}
@@ -22,13 +22,14 @@ import (
 
 // NAT ovnnb item
 type NAT struct {
-	UUID        string
-	Type        string
-	ExternalIP  string
-	ExternalMAC string
-	LogicalIP   string
-	LogicalPort string
-	ExternalID  map[interface{}]interface{}
+	UUID        string                      `ovsdb:"_uuid"`
+	Type        string                      `ovsdb:"type"`
+	ExternalIP  string                      `ovsdb:"external_ip"`
+	ExternalMAC string                      `ovsdb:"external_mac"`
+	LogicalIP   string                      `ovsdb:"logical_ip"`
+	LogicalPort string                      `ovsdb:"logical_port"`
+	ExternalID  map[interface{}]interface{} `ovsdb:"external_ids"`
+	PopulatedColumns
 }
 
 func (odbi *ovndb) rowToNat(uuid string) *NAT {
@@ -37,33 +38,11 @@ func (odbi *ovndb) rowToNat(uuid string) *NAT {
 		return nil
 	}
 
-	nat := &NAT{
-		UUID:       uuid,
-		Type:       cacheNAT.Fields["type"].(string),
-		ExternalIP: cacheNAT.Fields["external_ip"].(string),
-		LogicalIP:  cacheNAT.Fields["logical_ip"].(string),
-		ExternalID: cacheNAT.Fields["external_ids"].(libovsdb.OvsMap).GoMap,
-	}
-
-	if mac, ok := cacheNAT.Fields["external_mac"]; ok {
-		switch mac.(type) {
-		case libovsdb.UUID:
-			nat.ExternalMAC = mac.(libovsdb.UUID).GoUUID
-		case string:
-			nat.ExternalMAC = mac.(string)
-		}
-	}
-
-	if lip, ok := cacheNAT.Fields["logical_port"]; ok {
-		switch lip.(type) {
-		case libovsdb.UUID:
-			nat.LogicalIP = lip.(libovsdb.UUID).GoUUID
-		case string:
-			nat.LogicalIP = lip.(string)
-		}
-
+	nat := &NAT{}
+	if err := rowToModel(cacheNAT, uuid, nat); err != nil {
+		return nil
 	}
-
+	nat.PopulatedColumns = odbi.populated[TableNAT]
 	return nat
 }
 
@@ -234,10 +213,13 @@ func (odbi *ovndb) lrNatListImp(lr string) ([]*NAT, error) {
 		return nil, err
 	}
 
-	natlist := make([]*NAT, len(LRs[0].NAT))
+	natlist := make([]*NAT, 0, len(LRs[0].NAT))
 
-	for i, v := range LRs[0].NAT {
-		natlist[i] = odbi.rowToNat(v)
+	for _, v := range LRs[0].NAT {
+		// a NAT missing from the cache or not matching the model is skipped
+		if nat := odbi.rowToNat(v); nat != nil {
+			natlist = append(natlist, nat)
+		}
 	}
 
 	return natlist, nil
@@ -16,15 +16,42 @@
 
 package goovn
 
+import (
+	"context"
+	"fmt"
+
+	"github.com/ebay/libovsdb"
+)
+
 type NBGlobalTableRow struct {
-	UUID        string
-	Options     map[interface{}]interface{}
-	ExternalID  map[interface{}]interface{}
-	Connections []string
-	SSL         string
-	IPSec       bool
+	UUID           string
+	Name           string
+	Options        map[interface{}]interface{}
+	ExternalID     map[interface{}]interface{}
+	Connections    []string
+	SSL            string
+	IPSec          bool
+	NbCfg          int
+	NbCfgTimestamp int
+	SbCfg          int
+	SbCfgTimestamp int
+	HvCfg          int
+	HvCfgTimestamp int
+	PopulatedColumns
 }
 
+// SyncLevel is what WaitForSync waits for, like ovn-nbctl --wait
+type SyncLevel int
+
+const (
+	// SyncNone does not wait
+	SyncNone SyncLevel = iota
+	// SyncSB waits until ovn-northd has updated the southbound db
+	SyncSB
+	// SyncHV waits until all chassis have applied the southbound db
+	SyncHV
+)
+
 func (odbi *ovndb) nbGlobalAddImp(options map[string]string) (*OvnCommand, error) {
 	return odbi.addGlobalTableRowImp(options, TableNBGlobal)
 }
@@ -43,3 +70,149 @@ func (odbi *ovndb) nbGlobalSetOptionsImp(options map[string]string) (*OvnCommand
 func (odbi *ovndb) nbGlobalGetOptionsImp() (map[string]string, error) {
 	return odbi.globalGetOptionsImp(TableNBGlobal)
 }
+
+func (odbi *ovndb) nbGlobalGetImp() (*NBGlobalTableRow, error) {
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+
+	cacheNBGlobal, ok := odbi.cache[TableNBGlobal]
+	if !ok {
+		return nil, ErrorSchema
+	}
+	for uuid := range cacheNBGlobal {
+		return odbi.rowToNBGlobal(uuid), nil
+	}
+	return nil, ErrorNotFound
+}
+
+func (odbi *ovndb) rowToNBGlobal(uuid string) *NBGlobalTableRow {
+	cacheNBGlobal, ok := odbi.cache[TableNBGlobal][uuid]
+	if !ok {
+		return nil
+	}
+
+	nbGlobal := &NBGlobalTableRow{
+		UUID:           uuid,
+		Name:           rowString(cacheNBGlobal, "name"),
+		Options:        rowMap(cacheNBGlobal, "options"),
+		ExternalID:     rowMap(cacheNBGlobal, "external_ids"),
+		Connections:    rowSet(cacheNBGlobal, "connections"),
+		IPSec:          rowBool(cacheNBGlobal, "ipsec"),
+		NbCfg:          rowInt(cacheNBGlobal, "nb_cfg"),
+		NbCfgTimestamp: rowInt(cacheNBGlobal, "nb_cfg_timestamp"),
+		SbCfg:          rowInt(cacheNBGlobal, "sb_cfg"),
+		SbCfgTimestamp: rowInt(cacheNBGlobal, "sb_cfg_timestamp"),
+		HvCfg:          rowInt(cacheNBGlobal, "hv_cfg"),
+		HvCfgTimestamp: rowInt(cacheNBGlobal, "hv_cfg_timestamp"),
+	}
+	if ssl := rowSet(cacheNBGlobal, "ssl"); len(ssl) > 0 {
+		nbGlobal.SSL = ssl[0]
+	}
+	nbGlobal.PopulatedColumns = odbi.populated[TableNBGlobal]
+	return nbGlobal
+}
+
+// synced reports whether the sync level of NB_Global reached nbCfg
+func (odbi *ovndb) synced(level SyncLevel, nbCfg int) (bool, error) {
+	nbGlobal, err := odbi.nbGlobalGetImp()
+	if err != nil {
+		return false, err
+	}
+	switch level {
+	case SyncSB:
+		return nbGlobal.SbCfg >= nbCfg, nil
+	case SyncHV:
+		return nbGlobal.HvCfg >= nbCfg, nil
+	}
+	return true, nil
+}
+
+// Execute cmds together with an increment of NB_Global nb_cfg, then wait
+// until ovn-northd copies the new value to sb_cfg (SyncSB), or to hv_cfg once
+// the nb_cfg of every Chassis_Private row has caught up with it (SyncHV).
+func (odbi *ovndb) waitForSyncImp(ctx context.Context, level SyncLevel, cmds ...*OvnCommand) error {
+	if odbi.db != DBNB {
+		return ErrorOption
+	}
+	var ops []libovsdb.Operation
+	for _, cmd := range cmds {
+		if cmd != nil {
+			ops = append(ops, cmd.Operations...)
+		}
+	}
+	if level == SyncNone {
+		if len(ops) == 0 {
+			return nil
+		}
+		_, err := odbi.transactContext(ctx, odbi.db, ops...)
+		return err
+	}
+	// the progress is read from the cache, it never changes if not monitored
+	column := "sb_cfg"
+	if level == SyncHV {
+		column = "hv_cfg"
+	}
+	if _, ok := odbi.tableCols[TableNBGlobal]; !ok || !odbi.populated[TableNBGlobal].IsPopulated(column) {
+		return ErrorSchema
+	}
+
+	// subscribe before the transaction so that no update is missed
+	changed := make(chan struct{}, 1)
+	id, err := odbi.addEventHandlerImp(TableNBGlobal, nil, func(*Event) {
+		select {
+		case changed <- struct{}{}:
+		default:
+		}
+	})
+	if err != nil {
+		return err
+	}
+	defer odbi.removeEventHandlerImp(id)
+
+	uuid, err := odbi.globalTableRowUUID(TableNBGlobal)
+	if err != nil {
+		return err
+	}
+	condition := libovsdb.NewCondition("_uuid", "==", stringToGoUUID(uuid))
+	mutation := libovsdb.NewMutation("nb_cfg", "+=", 1)
+	ops = append(ops, libovsdb.Operation{
+		Op:        opMutate,
+		Table:     TableNBGlobal,
+		Mutations: []interface{}{mutation},
+		Where:     []interface{}{condition},
+	}, libovsdb.Operation{
+		Op:      opSelect,
+		Table:   TableNBGlobal,
+		Where:   []interface{}{condition},
+		Columns: []string{"nb_cfg"},
+	})
+	reply, err := odbi.transactContext(ctx, odbi.db, ops...)
+	if err != nil {
+		return err
+	}
+	rows := reply[len(ops)-1].Rows
+	if len(rows) != 1 {
+		return fmt.Errorf("expected one row in %s, got %d", TableNBGlobal, len(rows))
+	}
+	row := libovsdb.Row{Fields: rows[0]}
+	odbi.float64_to_int(row)
+	nbCfg, ok := row.Fields["nb_cfg"].(int)
+	if !ok {
+		return fmt.Errorf("unexpected nb_cfg %v", row.Fields["nb_cfg"])
+	}
+
+	for {
+		done, err := odbi.synced(level, nbCfg)
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
@@ -19,8 +19,10 @@
 package goovn
 
 import (
+	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -72,6 +74,26 @@ func TestNBGlobalAPI(t *testing.T) {
 	assert.Equal(t, ok, true)
 	assert.Equal(t, val, NB_GLOBAL_OPTIONS_1_VAL)
 
+	nbGlobal, err := ovndbapi.NBGlobalGet()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, NB_GLOBAL_OPTIONS_1_VAL, nbGlobal.Options[NB_GLOBAL_OPTIONS_1_KEY])
+
+	t.Logf("Waiting for ovn-northd to sync nb_cfg %d", nbGlobal.NbCfg+1)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	err = ovndbapi.WaitForSync(ctx, SyncSB)
+	// the test environment may run without ovn-northd
+	if err != context.DeadlineExceeded {
+		assert.Nil(t, err)
+	}
+	synced, err := ovndbapi.NBGlobalGet()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, nbGlobal.NbCfg+1, synced.NbCfg)
+
 	t.Logf("Deleting row from NB_Global table in OVN")
 	cmd, err = ovn.nbGlobalDel()
 	if err != nil {
@@ -17,6 +17,7 @@
 package goovn
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"reflect"
@@ -42,8 +43,54 @@ var (
 	ErrorNoChanges = errors.New("no changes requested")
 	// ErrorDuplicateName used when multiple rows are found when searching by name
 	ErrorDuplicateName = errors.New("duplicate name")
+	// ErrorTxnConflict used when a transaction kept conflicting with concurrent changes
+	ErrorTxnConflict = errors.New("transaction conflict")
+	// ErrorNotOwned used when Reconcile finds a row of a desired name that is not owned by the topology
+	ErrorNotOwned = errors.New("object not owned")
 )
 
+// Errors of RFC 7047 operations and commits that TransactionError.Kind is
+// compared against
+const (
+	TxnErrorConstraintViolation  = "constraint violation"
+	TxnErrorReferentialIntegrity = "referential integrity violation"
+	TxnErrorTimedOut             = "timed out"
+	TxnErrorNotLeader            = "not leader"
+)
+
+// TransactionError is returned when the db server rejects an operation of a
+// transaction, or the commit of the transaction.
+type TransactionError struct {
+	// Index of the failing operation, the number of operations if the
+	// commit failed
+	Index int
+	// Failing operation, nil if the commit failed
+	Operation *libovsdb.Operation
+	// RFC 7047 error, e.g. TxnErrorConstraintViolation
+	Kind    string
+	Details string
+}
+
+func (e *TransactionError) Error() string {
+	opsInfo := commitTransactionText
+	if e.Operation != nil {
+		opsInfo = fmt.Sprintf("%v", *e.Operation)
+	}
+	return fmt.Sprintf("Transaction Failed due to an error: %v details: %v in %s", e.Kind, e.Details, opsInfo)
+}
+
+// Is makes errors.Is match ErrorExist for a duplicate of a unique index and
+// ErrorNotFound for a reference to a row that does not exist.
+func (e *TransactionError) Is(target error) bool {
+	switch target {
+	case ErrorExist:
+		return e.Kind == TxnErrorConstraintViolation && strings.Contains(e.Details, "identical values")
+	case ErrorNotFound:
+		return e.Kind == TxnErrorReferentialIntegrity && strings.Contains(e.Details, "nonexistent")
+	}
+	return false
+}
+
 // OVNRow ovn nb/sb row
 type OVNRow map[string]interface{}
 
@@ -83,6 +130,9 @@ func (odbi *ovndb) getRowUUIDs(table string, row OVNRow) []string {
 		}
 	}
 
+	if odbi.txnReads != nil && !wildcard {
+		odbi.txnReads.record(table, row)
+	}
 	return uuids
 }
 
@@ -146,12 +196,10 @@ func (odbi *ovndb) getRowsMatchingUUID(table, field, uuid string) ([]string, err
 }
 
 func (odbi *ovndb) transact(db string, ops ...libovsdb.Operation) ([]libovsdb.OperationResult, error) {
-	reply, err := odbi.client.Transact(db, ops...)
-
-	if err != nil {
-		return reply, err
-	}
+	return odbi.transactContext(context.Background(), db, ops...)
+}
 
+func checkOperationResults(reply []libovsdb.OperationResult, ops []libovsdb.Operation) ([]libovsdb.OperationResult, error) {
 	// Per RFC 7047 Section 4.1.3, the operation result array in the transact response object
 	// maps one-to-one with operations array in the transact request object. We need to check
 	// each of the operation result for null error to ensure that the transaction has succeeded.
@@ -160,12 +208,11 @@ func (odbi *ovndb) transact(db string, ops ...libovsdb.Operation) ([]libovsdb.Op
 			// Per RFC 7047 Section 4.1.3, if all of the operations succeed, but the results
 			// cannot be committed, then "result" will have one more element than "params",
 			// with the additional element being an <error>.
-			opsInfo := commitTransactionText
+			txnErr := &TransactionError{Index: i, Kind: o.Error, Details: o.Details}
 			if i < len(ops) {
-				opsInfo = fmt.Sprintf("%v", ops[i])
+				txnErr.Operation = &ops[i]
 			}
-			return nil, fmt.Errorf("Transaction Failed due to an error: %v details: %v in %s",
-				o.Error, o.Details, opsInfo)
+			return nil, txnErr
 		}
 	}
 	if len(reply) < len(ops) {
@@ -180,6 +227,10 @@ func (odbi *ovndb) execute(cmds ...*OvnCommand) error {
 }
 
 func (odbi *ovndb) executeR(cmds ...*OvnCommand) ([]string, error) {
+	return odbi.executeRContext(context.Background(), cmds...)
+}
+
+func (odbi *ovndb) executeRContext(ctx context.Context, cmds ...*OvnCommand) ([]string, error) {
 	if cmds == nil {
 		return nil, nil
 	}
@@ -190,11 +241,14 @@ func (odbi *ovndb) executeR(cmds ...*OvnCommand) ([]string, error) {
 		}
 	}
 
-	results, err := odbi.transact(odbi.db, ops...)
+	results, err := odbi.transactContext(ctx, odbi.db, ops...)
 	if err != nil {
 		return nil, err
 	}
+	return resultUUIDs(results), nil
+}
 
+func resultUUIDs(results []libovsdb.OperationResult) []string {
 	// The total number of UUIDs will be <= number of results returned.
 	UUIDs := make([]string, 0, len(results))
 	for _, r := range results {
@@ -204,10 +258,10 @@ func (odbi *ovndb) executeR(cmds ...*OvnCommand) ([]string, error) {
 	}
 
 	if len(UUIDs) > 0 {
-		return UUIDs, nil
+		return UUIDs
 	}
 
-	return nil, nil
+	return nil
 }
 
 func (odbi *ovndb) float64_to_int(row libovsdb.Row) {
@@ -221,8 +275,12 @@ func (odbi *ovndb) float64_to_int(row libovsdb.Row) {
 	}
 }
 
-func (odbi *ovndb) populateCache(updates libovsdb.TableUpdates) {
+// populateCache applies updates to the cache and returns the events for the
+// changed rows. The cache must be locked for writing by the caller, the
+// events are delivered by the dispatch queue once the lock is released.
+func (odbi *ovndb) populateCache(updates libovsdb.TableUpdates) []*queuedEvent {
 	empty := libovsdb.Row{}
+	var events, deletes []*queuedEvent
 
 	for table := range odbi.tableCols {
 		tableUpdate, ok := updates.Updates[table]
@@ -233,116 +291,319 @@ func (odbi *ovndb) populateCache(updates libovsdb.TableUpdates) {
 		if _, ok := odbi.cache[table]; !ok {
 			odbi.cache[table] = make(map[string]libovsdb.Row)
 		}
+		handlers := odbi.eventHandlers.forTable(table)
+		notify := odbi.signalCB != nil || len(handlers) > 0
 		for uuid, row := range tableUpdate.Rows {
 			// TODO: this is a workaround for the problem of
 			// missing json number conversion in libovsdb
 			odbi.float64_to_int(row.New)
+			odbi.debug("row update", "table", table, "uuid", uuid, "new", row.New.Fields)
 
 			if !reflect.DeepEqual(row.New, empty) {
-				if reflect.DeepEqual(row.New, odbi.cache[table][uuid]) {
+				oldRow, existed := odbi.cache[table][uuid]
+				if reflect.DeepEqual(row.New, oldRow) {
 					// Already existed and unchanged, ignore (this can happen when auto-reconnect)
 					continue
 				}
-				odbi.cache[table][uuid] = row.New
-
-				if odbi.signalCB != nil {
-					switch table {
-					case TableLogicalRouter:
-						lr := odbi.rowToLogicalRouter(uuid)
-						odbi.signalCB.OnLogicalRouterCreate(lr)
-					case TableLogicalRouterPort:
-						lrp := odbi.rowToLogicalRouterPort(uuid)
-						odbi.signalCB.OnLogicalRouterPortCreate(lrp)
-					case TableLogicalRouterStaticRoute:
-						lrsr := odbi.rowToLogicalRouterStaticRoute(uuid)
-						odbi.signalCB.OnLogicalRouterStaticRouteCreate(lrsr)
-					case TableLogicalSwitch:
-						ls := odbi.rowToLogicalSwitch(uuid)
-						odbi.signalCB.OnLogicalSwitchCreate(ls)
-					case TableLogicalSwitchPort:
-						lp, err := odbi.rowToLogicalPort(uuid)
-						if err == nil {
-							odbi.signalCB.OnLogicalPortCreate(lp)
-						}
-					case TableACL:
-						acl := odbi.rowToACL(uuid)
-						odbi.signalCB.OnACLCreate(acl)
-					case TableDHCPOptions:
-						dhcp := odbi.rowToDHCPOptions(uuid)
-						odbi.signalCB.OnDHCPOptionsCreate(dhcp)
-					case TableQoS:
-						qos := odbi.rowToQoS(uuid)
-						odbi.signalCB.OnQoSCreate(qos)
-					case TableLoadBalancer:
-						lb, _ := odbi.rowToLB(uuid)
-						odbi.signalCB.OnLoadBalancerCreate(lb)
-					case TableMeter:
-						meter := odbi.rowToMeter(uuid)
-						odbi.signalCB.OnMeterCreate(meter)
-					case TableMeterBand:
-						band, _ := odbi.rowToMeterBand(uuid)
-						odbi.signalCB.OnMeterBandCreate(band)
-					case TableChassis:
-						chassis, _ := odbi.rowToChassis(uuid)
-						odbi.signalCB.OnChassisCreate(chassis)
-					case TableEncap:
-						encap, _ := odbi.rowToEncap(uuid)
-						odbi.signalCB.OnEncapCreate(encap)
-					}
+				if !notify {
+					odbi.cache[table][uuid] = row.New
+					continue
 				}
-			} else {
-				defer delete(odbi.cache[table], uuid)
-
-				if odbi.signalCB != nil {
-					defer func(table, uuid string) {
-						switch table {
-						case TableLogicalRouter:
-							lr := odbi.rowToLogicalRouter(uuid)
-							odbi.signalCB.OnLogicalRouterDelete(lr)
-						case TableLogicalRouterPort:
-							lrp := odbi.rowToLogicalRouterPort(uuid)
-							odbi.signalCB.OnLogicalRouterPortDelete(lrp)
-						case TableLogicalRouterStaticRoute:
-							lrsr := odbi.rowToLogicalRouterStaticRoute(uuid)
-							odbi.signalCB.OnLogicalRouterStaticRouteDelete(lrsr)
-						case TableLogicalSwitch:
-							ls := odbi.rowToLogicalSwitch(uuid)
-							odbi.signalCB.OnLogicalSwitchDelete(ls)
-						case TableLogicalSwitchPort:
-							lp, err := odbi.rowToLogicalPort(uuid)
-							if err == nil {
-								odbi.signalCB.OnLogicalPortDelete(lp)
-							}
-						case TableACL:
-							acl := odbi.rowToACL(uuid)
-							odbi.signalCB.OnACLDelete(acl)
-						case TableDHCPOptions:
-							dhcp := odbi.rowToDHCPOptions(uuid)
-							odbi.signalCB.OnDHCPOptionsDelete(dhcp)
-						case TableQoS:
-							qos := odbi.rowToQoS(uuid)
-							odbi.signalCB.OnQoSDelete(qos)
-						case TableLoadBalancer:
-							lb, _ := odbi.rowToLB(uuid)
-							odbi.signalCB.OnLoadBalancerDelete(lb)
-						case TableMeter:
-							meter := odbi.rowToMeter(uuid)
-							odbi.signalCB.OnMeterDelete(meter)
-						case TableMeterBand:
-							band, _ := odbi.rowToMeterBand(uuid)
-							odbi.signalCB.OnMeterBandDelete(band)
-						case TableChassis:
-							chassis, _ := odbi.rowToChassis(uuid)
-							odbi.signalCB.OnChassisDelete(chassis)
-						case TableEncap:
-							encap, _ := odbi.rowToEncap(uuid)
-							odbi.signalCB.OnEncapDelete(encap)
-						}
-					}(table, uuid)
+				newRow := row.New
+				event := &Event{
+					Type:   EventAdd,
+					Table:  table,
+					UUID:   uuid,
+					NewRow: &newRow,
 				}
+				if existed {
+					// the old object is built from the cache before it is overwritten
+					event.Type = EventUpdate
+					event.Old = odbi.rowToObject(table, uuid)
+					event.OldRow = &oldRow
+				}
+				odbi.cache[table][uuid] = row.New
+				event.New = odbi.rowToObject(table, uuid)
+				events = append(events, &queuedEvent{event, handlers})
+			} else if notify {
+				// deleted rows are converted once all updates are applied
+				deletes = append(deletes, &queuedEvent{&Event{
+					Type:  EventDelete,
+					Table: table,
+					UUID:  uuid,
+				}, handlers})
+			} else {
+				delete(odbi.cache[table], uuid)
+			}
+		}
+	}
+
+	for _, qe := range deletes {
+		oldRow, ok := odbi.cache[qe.event.Table][qe.event.UUID]
+		if !ok {
+			continue
+		}
+		qe.event.Old = odbi.rowToObject(qe.event.Table, qe.event.UUID)
+		qe.event.OldRow = &oldRow
+		events = append(events, qe)
+	}
+	for _, qe := range deletes {
+		delete(odbi.cache[qe.event.Table], qe.event.UUID)
+	}
+	if odbi.metrics != nil {
+		for table := range updates.Updates {
+			if rows, ok := odbi.cache[table]; ok {
+				odbi.metrics.CacheRows(odbi.db, table, len(rows))
 			}
 		}
 	}
+	return events
+}
+
+// deliver calls the OVNSignal callback and the event handlers for event
+func (odbi *ovndb) deliver(qe *queuedEvent) {
+	if odbi.signalCB != nil {
+		switch qe.event.Type {
+		case EventAdd:
+			odbi.signalCreate(qe.event.New)
+		case EventUpdate:
+			odbi.signalUpdate(qe.event.Old, qe.event.New)
+		case EventDelete:
+			odbi.signalDelete(qe.event.Old)
+		}
+	}
+	dispatchEvent(qe.handlers, qe.event)
+}
+
+func (odbi *ovndb) signalCreate(obj interface{}) {
+	switch o := obj.(type) {
+	case *LogicalRouter:
+		odbi.signalCB.OnLogicalRouterCreate(o)
+	case *LogicalRouterPort:
+		odbi.signalCB.OnLogicalRouterPortCreate(o)
+	case *LogicalRouterStaticRoute:
+		odbi.signalCB.OnLogicalRouterStaticRouteCreate(o)
+	case *LogicalSwitch:
+		odbi.signalCB.OnLogicalSwitchCreate(o)
+	case *LogicalSwitchPort:
+		odbi.signalCB.OnLogicalPortCreate(o)
+	case *ACL:
+		odbi.signalCB.OnACLCreate(o)
+	case *DHCPOptions:
+		odbi.signalCB.OnDHCPOptionsCreate(o)
+	case *QoS:
+		odbi.signalCB.OnQoSCreate(o)
+	case *LoadBalancer:
+		odbi.signalCB.OnLoadBalancerCreate(o)
+	case *Meter:
+		odbi.signalCB.OnMeterCreate(o)
+	case *MeterBand:
+		odbi.signalCB.OnMeterBandCreate(o)
+	case *Chassis:
+		odbi.signalCB.OnChassisCreate(o)
+	case *Encap:
+		odbi.signalCB.OnEncapCreate(o)
+	case *PortBinding:
+		if pbs, ok := odbi.signalCB.(OVNPortBindingSignal); ok {
+			pbs.OnPortBindingCreate(o)
+		}
+	}
+}
+
+func (odbi *ovndb) signalDelete(obj interface{}) {
+	switch o := obj.(type) {
+	case *LogicalRouter:
+		odbi.signalCB.OnLogicalRouterDelete(o)
+	case *LogicalRouterPort:
+		odbi.signalCB.OnLogicalRouterPortDelete(o)
+	case *LogicalRouterStaticRoute:
+		odbi.signalCB.OnLogicalRouterStaticRouteDelete(o)
+	case *LogicalSwitch:
+		odbi.signalCB.OnLogicalSwitchDelete(o)
+	case *LogicalSwitchPort:
+		odbi.signalCB.OnLogicalPortDelete(o)
+	case *ACL:
+		odbi.signalCB.OnACLDelete(o)
+	case *DHCPOptions:
+		odbi.signalCB.OnDHCPOptionsDelete(o)
+	case *QoS:
+		odbi.signalCB.OnQoSDelete(o)
+	case *LoadBalancer:
+		odbi.signalCB.OnLoadBalancerDelete(o)
+	case *Meter:
+		odbi.signalCB.OnMeterDelete(o)
+	case *MeterBand:
+		odbi.signalCB.OnMeterBandDelete(o)
+	case *Chassis:
+		odbi.signalCB.OnChassisDelete(o)
+	case *Encap:
+		odbi.signalCB.OnEncapDelete(o)
+	case *PortBinding:
+		if pbs, ok := odbi.signalCB.(OVNPortBindingSignal); ok {
+			pbs.OnPortBindingDelete(o)
+		}
+	}
+}
+
+// rowToObject converts the cached row of table to its typed object, e.g.
+// *LogicalSwitch, or returns nil if the table has no typed API.
+func (odbi *ovndb) rowToObject(table, uuid string) interface{} {
+	var obj interface{}
+	var err error
+	switch table {
+	case TableLogicalRouter:
+		if lr := odbi.rowToLogicalRouter(uuid); lr != nil {
+			obj = lr
+		}
+	case TableLogicalRouterPort:
+		if lrp := odbi.rowToLogicalRouterPort(uuid); lrp != nil {
+			obj = lrp
+		}
+	case TableLogicalRouterStaticRoute:
+		if lrsr := odbi.rowToLogicalRouterStaticRoute(uuid); lrsr != nil {
+			obj = lrsr
+		}
+	case TableLogicalRouterPolicy:
+		if lrpolicy := odbi.rowToLogicalRouterPolicy(uuid); lrpolicy != nil {
+			obj = lrpolicy
+		}
+	case TableLogicalSwitch:
+		if ls := odbi.rowToLogicalSwitch(uuid); ls != nil {
+			obj = ls
+		}
+	case TableLogicalSwitchPort:
+		obj, err = odbi.rowToLogicalPort(uuid)
+	case TableACL:
+		if acl := odbi.rowToACL(uuid); acl != nil {
+			obj = acl
+		}
+	case TableAddressSet:
+		if as := odbi.rowToAddressSet(uuid); as != nil {
+			obj = as
+		}
+	case TablePortGroup:
+		if pg := odbi.RowToPortGroup(uuid); pg != nil {
+			obj = pg
+		}
+	case TableNBGlobal:
+		if nbGlobal := odbi.rowToNBGlobal(uuid); nbGlobal != nil {
+			obj = nbGlobal
+		}
+	case TableSBGlobal:
+		if sbGlobal := odbi.rowToSBGlobal(uuid); sbGlobal != nil {
+			obj = sbGlobal
+		}
+	case TableConnection:
+		if conn := odbi.rowToConnection(uuid); conn != nil {
+			obj = conn
+		}
+	case TableSSL:
+		if ssl := odbi.rowToSSL(uuid); ssl != nil {
+			obj = ssl
+		}
+	case TableDNS:
+		if dns := odbi.rowToDNS(uuid); dns != nil {
+			obj = dns
+		}
+	case TableGatewayChassis:
+		if gc := odbi.rowToGatewayChassis(uuid); gc != nil {
+			obj = gc
+		}
+	case TableHAChassisGroup:
+		if group := odbi.rowToHAChassisGroup(uuid); group != nil {
+			obj = group
+		}
+	case TableHAChassis:
+		if ha := odbi.rowToHAChassis(uuid); ha != nil {
+			obj = ha
+		}
+	case TableNAT:
+		if nat := odbi.rowToNat(uuid); nat != nil {
+			obj = nat
+		}
+	case TableDHCPOptions:
+		if dhcp := odbi.rowToDHCPOptions(uuid); dhcp != nil {
+			obj = dhcp
+		}
+	case TableQoS:
+		if qos := odbi.rowToQoS(uuid); qos != nil {
+			obj = qos
+		}
+	case TableLoadBalancer:
+		obj, err = odbi.rowToLB(uuid)
+	case TableMeter:
+		if meter := odbi.rowToMeter(uuid); meter != nil {
+			obj = meter
+		}
+	case TableMeterBand:
+		obj, err = odbi.rowToMeterBand(uuid)
+	case TableChassis:
+		obj, err = odbi.rowToChassis(uuid)
+	case TableChassisPrivate:
+		obj, err = odbi.rowToChassisPrivate(uuid)
+	case TableEncap:
+		obj, err = odbi.rowToEncap(uuid)
+	case TablePortBinding:
+		obj, err = odbi.rowToPortBinding(uuid)
+	case TableDatapathBinding:
+		obj, err = odbi.rowToDatapathBinding(uuid)
+	case TableLogicalFlow:
+		obj, err = odbi.rowToLogicalFlow(uuid)
+	}
+	if err != nil {
+		return nil
+	}
+	return obj
+}
+
+func (odbi *ovndb) signalUpdate(old, new interface{}) {
+	if new == nil {
+		return
+	}
+	if o, ok := new.(*PortBinding); ok {
+		if pbs, ok := odbi.signalCB.(OVNPortBindingSignal); ok && old != nil {
+			pbs.OnPortBindingUpdate(old.(*PortBinding), o)
+		}
+		return
+	}
+	updater, ok := odbi.signalCB.(OVNSignalUpdater)
+	if !ok {
+		// without the update callbacks a modified row is reported to the
+		// create callbacks with the new object, as before they existed
+		odbi.signalCreate(new)
+		return
+	}
+	if old == nil {
+		return
+	}
+	switch o := new.(type) {
+	case *LogicalRouter:
+		updater.OnLogicalRouterUpdate(old.(*LogicalRouter), o)
+	case *LogicalRouterPort:
+		updater.OnLogicalRouterPortUpdate(old.(*LogicalRouterPort), o)
+	case *LogicalRouterStaticRoute:
+		updater.OnLogicalRouterStaticRouteUpdate(old.(*LogicalRouterStaticRoute), o)
+	case *LogicalSwitch:
+		updater.OnLogicalSwitchUpdate(old.(*LogicalSwitch), o)
+	case *LogicalSwitchPort:
+		updater.OnLogicalPortUpdate(old.(*LogicalSwitchPort), o)
+	case *ACL:
+		updater.OnACLUpdate(old.(*ACL), o)
+	case *DHCPOptions:
+		updater.OnDHCPOptionsUpdate(old.(*DHCPOptions), o)
+	case *QoS:
+		updater.OnQoSUpdate(old.(*QoS), o)
+	case *LoadBalancer:
+		updater.OnLoadBalancerUpdate(old.(*LoadBalancer), o)
+	case *Meter:
+		updater.OnMeterUpdate(old.(*Meter), o)
+	case *MeterBand:
+		updater.OnMeterBandUpdate(old.(*MeterBand), o)
+	case *Chassis:
+		updater.OnChassisUpdate(old.(*Chassis), o)
+	case *Encap:
+		updater.OnEncapUpdate(old.(*Encap), o)
+	}
 }
 
 func (odbi *ovndb) ConvertGoSetToStringArray(oset libovsdb.OvsSet) []string {
@@ -1,8 +1,10 @@
 package goovn
 
 import (
+	"errors"
 	"testing"
 
+	"github.com/ebay/libovsdb"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -32,6 +34,11 @@ func TestBadTransact(t *testing.T) {
 	// with UUID 10d7d018-7444-48de-89fc-cb062f88e520, was inserted by this transaction."
 	err = ovndbapi.Execute(ocmd)
 	assert.Error(t, err)
+	txnErr, ok := err.(*TransactionError)
+	if assert.True(t, ok) {
+		assert.Equal(t, TxnErrorConstraintViolation, txnErr.Kind)
+	}
+	assert.True(t, errors.Is(err, ErrorExist))
 
 	t.Logf("Deleting Chassis:%v", CHASSIS_NAME)
 	ocmd, err = ovndbapi.ChassisDel(CHASSIS_NAME)
@@ -297,6 +304,35 @@ func TestAuxKeyValSetDel(t *testing.T) {
 	}
 }
 
+func TestCheckOperationResults(t *testing.T) {
+	ops := []libovsdb.Operation{
+		{Op: opInsert, Table: TableLogicalSwitch},
+		{Op: opMutate, Table: TableLogicalSwitch},
+	}
+	_, err := checkOperationResults([]libovsdb.OperationResult{
+		{},
+		{Error: TxnErrorReferentialIntegrity, Details: "Table Logical_Switch column ports row 8e49d4c1 references nonexistent row 1b1cb5a3 in table Logical_Switch_Port."},
+	}, ops)
+	txnErr, ok := err.(*TransactionError)
+	if !ok {
+		t.Fatalf("unexpected error %v", err)
+	}
+	assert.Equal(t, 1, txnErr.Index)
+	assert.Equal(t, &ops[1], txnErr.Operation)
+	assert.True(t, errors.Is(err, ErrorNotFound))
+	assert.False(t, errors.Is(err, ErrorExist))
+
+	// the commit failed
+	_, err = checkOperationResults([]libovsdb.OperationResult{{}, {}, {Error: TxnErrorNotLeader}}, ops)
+	txnErr, ok = err.(*TransactionError)
+	if !ok {
+		t.Fatalf("unexpected error %v", err)
+	}
+	assert.Equal(t, len(ops), txnErr.Index)
+	assert.Nil(t, txnErr.Operation)
+	assert.Contains(t, err.Error(), commitTransactionText)
+}
+
 func strPtr(str string) *string {
 	return &str
 }
@@ -25,9 +25,19 @@ type ovnNotifier struct {
 }
 
 func (notify ovnNotifier) Update(context interface{}, tableUpdates libovsdb.TableUpdates) {
+	if context == serverMonitorContext {
+		notify.odbi.checkLeader(tableUpdates)
+		return
+	}
+	if notify.odbi.metrics != nil {
+		for table, tableUpdate := range tableUpdates.Updates {
+			notify.odbi.metrics.UpdateReceived(notify.odbi.db, table, len(tableUpdate.Rows))
+		}
+	}
 	notify.odbi.cachemutex.Lock()
-	defer notify.odbi.cachemutex.Unlock()
-	notify.odbi.populateCache(tableUpdates)
+	notify.odbi.events.stage(notify.odbi.populateCache(tableUpdates))
+	notify.odbi.cachemutex.Unlock()
+	notify.odbi.events.flush()
 }
 func (notify ovnNotifier) Locked([]interface{}) {
 }
@@ -37,7 +47,8 @@ func (notify ovnNotifier) Echo([]interface{}) {
 }
 
 func (notify ovnNotifier) Disconnected(client *libovsdb.OvsdbClient) {
-	if notify.odbi.reconn {
+	notify.odbi.setConnected(false)
+	if notify.odbi.reconn && !notify.odbi.isClosed() {
 		notify.odbi.reconnect()
 	} else if notify.odbi.disconnectCB != nil {
 		notify.odbi.disconnectCB()
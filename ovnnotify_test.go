@@ -0,0 +1,105 @@
+/**
+ * Copyright (c) 2020 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	SIGNAL_LS = "SIGNAL_LS"
+)
+
+type lsUpdate struct {
+	old, new *LogicalSwitch
+}
+
+type updateSignal struct {
+	signal
+	created chan *LogicalSwitch
+	updated chan lsUpdate
+}
+
+func (s updateSignal) OnLogicalSwitchCreate(ls *LogicalSwitch) {
+	if ls.Name == SIGNAL_LS {
+		s.created <- ls
+	}
+}
+
+func (s updateSignal) OnLogicalSwitchUpdate(old, new *LogicalSwitch) {
+	if new.Name == SIGNAL_LS {
+		s.updated <- lsUpdate{old, new}
+	}
+}
+
+func TestSignalUpdate(t *testing.T) {
+	cfg := buildOvnDbConfig(DBNB)
+	sig := updateSignal{
+		created: make(chan *LogicalSwitch, 1),
+		updated: make(chan lsUpdate, 1),
+	}
+	cfg.SignalCB = sig
+	api, err := NewClient(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer api.Close()
+
+	cmd, err := api.LSAdd(SIGNAL_LS)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = api.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case ls := <-sig.created:
+		assert.Equal(t, SIGNAL_LS, ls.Name)
+	case <-time.After(5 * time.Second):
+		t.Fatal("no create notification for logical switch")
+	}
+
+	cmd, err = api.LSExtIdsAdd(SIGNAL_LS, map[string]string{FOO: BAR})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = api.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case update := <-sig.updated:
+		assert.Equal(t, 0, len(update.old.ExternalID))
+		assert.Equal(t, BAR, update.new.ExternalID[FOO])
+	case <-time.After(5 * time.Second):
+		t.Fatal("no update notification for logical switch")
+	}
+	assert.Equal(t, 0, len(sig.created))
+
+	cmd, err = api.LSDel(SIGNAL_LS)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = api.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,207 @@
+/**
+ * Copyright (c) 2020 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn
+
+import (
+	"fmt"
+
+	"github.com/ebay/libovsdb"
+)
+
+// PortBinding table OVN SB
+type PortBinding struct {
+	UUID           string
+	LogicalPort    string
+	Type           string
+	Datapath       string
+	TunnelKey      int
+	ParentPort     string
+	Tag            int
+	VirtualParent  string
+	Chassis        string
+	Encap          string
+	GatewayChassis []string
+	HAChassisGroup string
+	MAC            []string
+	NatAddresses   []string
+	Up             bool
+	Options        map[interface{}]interface{}
+	ExternalID     map[interface{}]interface{}
+	PopulatedColumns
+}
+
+func (odbi *ovndb) portBindingListImp() ([]*PortBinding, error) {
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+
+	cachePortBinding, ok := odbi.cache[TablePortBinding]
+	if !ok {
+		return nil, ErrorSchema
+	}
+
+	listPortBinding := make([]*PortBinding, 0, len(cachePortBinding))
+	for uuid := range cachePortBinding {
+		pb, err := odbi.rowToPortBinding(uuid)
+		if err != nil {
+			return nil, err
+		}
+		listPortBinding = append(listPortBinding, pb)
+	}
+	return listPortBinding, nil
+}
+
+func (odbi *ovndb) portBindingGetImp(uuid string) (*PortBinding, error) {
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+
+	cachePortBinding, ok := odbi.cache[TablePortBinding]
+	if !ok {
+		return nil, ErrorSchema
+	}
+	if _, ok := cachePortBinding[uuid]; !ok {
+		return nil, ErrorNotFound
+	}
+	return odbi.rowToPortBinding(uuid)
+}
+
+func (odbi *ovndb) portBindingGetByLogicalPortImp(lport string) (*PortBinding, error) {
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+
+	cachePortBinding, ok := odbi.cache[TablePortBinding]
+	if !ok {
+		return nil, ErrorSchema
+	}
+
+	for uuid, drows := range cachePortBinding {
+		if lp, ok := drows.Fields["logical_port"].(string); ok && lp == lport {
+			return odbi.rowToPortBinding(uuid)
+		}
+	}
+	return nil, ErrorNotFound
+}
+
+// List port bindings bound to the chassis with given name or hostname
+func (odbi *ovndb) portBindingListByChassisImp(chassis string) ([]*PortBinding, error) {
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+
+	cachePortBinding, ok := odbi.cache[TablePortBinding]
+	if !ok {
+		return nil, ErrorSchema
+	}
+
+	chassisUUIDs := make(map[string]bool)
+	for uuid, drows := range odbi.cache[TableChassis] {
+		if chName, ok := drows.Fields["name"].(string); ok && chName == chassis {
+			chassisUUIDs[uuid] = true
+		} else if chName, ok := drows.Fields["hostname"].(string); ok && chName == chassis {
+			chassisUUIDs[uuid] = true
+		}
+	}
+	if len(chassisUUIDs) == 0 {
+		return nil, ErrorNotFound
+	}
+
+	var listPortBinding []*PortBinding
+	for uuid, drows := range cachePortBinding {
+		if ch, ok := drows.Fields["chassis"].(libovsdb.UUID); ok && chassisUUIDs[ch.GoUUID] {
+			pb, err := odbi.rowToPortBinding(uuid)
+			if err != nil {
+				return nil, err
+			}
+			listPortBinding = append(listPortBinding, pb)
+		}
+	}
+	return listPortBinding, nil
+}
+
+func (odbi *ovndb) rowToPortBinding(uuid string) (*PortBinding, error) {
+	row, ok := odbi.cache[TablePortBinding][uuid]
+	if !ok {
+		return nil, fmt.Errorf("Port binding with uuid %s not found", uuid)
+	}
+
+	pb := &PortBinding{
+		UUID: uuid,
+	}
+	if lport, ok := row.Fields["logical_port"].(string); ok {
+		pb.LogicalPort = lport
+	}
+	if ptype, ok := row.Fields["type"].(string); ok {
+		pb.Type = ptype
+	}
+	if dp, ok := row.Fields["datapath"].(libovsdb.UUID); ok {
+		pb.Datapath = dp.GoUUID
+	}
+	if key, ok := row.Fields["tunnel_key"].(int); ok {
+		pb.TunnelKey = key
+	}
+	if parent := odbi.optionalStringFieldToPointer(row.Fields["parent_port"]); parent != nil {
+		pb.ParentPort = *parent
+	}
+	if tag, ok := row.Fields["tag"].(int); ok {
+		pb.Tag = tag
+	}
+	if vparent := odbi.optionalStringFieldToPointer(row.Fields["virtual_parent"]); vparent != nil {
+		pb.VirtualParent = *vparent
+	}
+	if ch, ok := row.Fields["chassis"].(libovsdb.UUID); ok {
+		pb.Chassis = ch.GoUUID
+	}
+	if encap, ok := row.Fields["encap"].(libovsdb.UUID); ok {
+		pb.Encap = encap.GoUUID
+	}
+	if hagrp, ok := row.Fields["ha_chassis_group"].(libovsdb.UUID); ok {
+		pb.HAChassisGroup = hagrp.GoUUID
+	}
+	if up, ok := row.Fields["up"].(bool); ok {
+		pb.Up = up
+	}
+	if gwch, ok := row.Fields["gateway_chassis"]; ok {
+		switch gwch.(type) {
+		case libovsdb.UUID:
+			pb.GatewayChassis = []string{gwch.(libovsdb.UUID).GoUUID}
+		case libovsdb.OvsSet:
+			pb.GatewayChassis = odbi.ConvertGoSetToStringArray(gwch.(libovsdb.OvsSet))
+		}
+	}
+	if mac, ok := row.Fields["mac"]; ok {
+		switch mac.(type) {
+		case string:
+			pb.MAC = []string{mac.(string)}
+		case libovsdb.OvsSet:
+			pb.MAC = odbi.ConvertGoSetToStringArray(mac.(libovsdb.OvsSet))
+		}
+	}
+	if nat, ok := row.Fields["nat_addresses"]; ok {
+		switch nat.(type) {
+		case string:
+			pb.NatAddresses = []string{nat.(string)}
+		case libovsdb.OvsSet:
+			pb.NatAddresses = odbi.ConvertGoSetToStringArray(nat.(libovsdb.OvsSet))
+		}
+	}
+	if options, ok := row.Fields["options"].(libovsdb.OvsMap); ok {
+		pb.Options = options.GoMap
+	}
+	if extIds, ok := row.Fields["external_ids"].(libovsdb.OvsMap); ok {
+		pb.ExternalID = extIds.GoMap
+	}
+	pb.PopulatedColumns = odbi.populated[TablePortBinding]
+	return pb, nil
+}
@@ -0,0 +1,148 @@
+/**
+ * Copyright (c) 2020 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn
+
+import (
+	"testing"
+
+	"github.com/ebay/libovsdb"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	PB_LPORT      = "pb-lport"
+	PB_TUNNEL_KEY = 4242
+)
+
+// Port_Binding rows are normally created by ovn-northd, so the test inserts
+// one together with its Datapath_Binding directly.
+func addPortBinding(t *testing.T, api Client, lport string, tunnelKey int) {
+	dpUUID, err := newRowUUID()
+	if err != nil {
+		t.Fatal(err)
+	}
+	dpRow := make(OVNRow)
+	dpRow["tunnel_key"] = tunnelKey
+	pbRow := make(OVNRow)
+	pbRow["logical_port"] = lport
+	pbRow["tunnel_key"] = 1
+	pbRow["datapath"] = stringToGoUUID(dpUUID)
+	operations := []libovsdb.Operation{
+		{Op: opInsert, Table: TableDatapathBinding, Row: dpRow, UUIDName: dpUUID},
+		{Op: opInsert, Table: TablePortBinding, Row: pbRow},
+	}
+	err = api.Execute(&OvnCommand{operations, api.(*ovndb), make([][]map[string]interface{}, len(operations))})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func delPortBinding(t *testing.T, api Client, lport string, tunnelKey int) {
+	operations := []libovsdb.Operation{
+		{Op: opDelete, Table: TablePortBinding, Where: []interface{}{libovsdb.NewCondition("logical_port", "==", lport)}},
+		{Op: opDelete, Table: TableDatapathBinding, Where: []interface{}{libovsdb.NewCondition("tunnel_key", "==", tunnelKey)}},
+	}
+	err := api.Execute(&OvnCommand{operations, api.(*ovndb), make([][]map[string]interface{}, len(operations))})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPortBinding(t *testing.T) {
+	ovndbapi := getOVNClient(DBSB)
+
+	t.Logf("Adding Chassis %s to OVN SB DB", CHASSIS_NAME)
+	ocmd, err := ovndbapi.ChassisAdd(CHASSIS_NAME, CHASSIS_HOSTNAME, ENCAP_TYPES, IP, nil, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(ocmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	chassis, err := ovndbapi.ChassisGet(CHASSIS_NAME)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 1, len(chassis))
+
+	t.Logf("Adding port binding %s", PB_LPORT)
+	addPortBinding(t, ovndbapi, PB_LPORT, PB_TUNNEL_KEY)
+
+	pbs, err := ovndbapi.PortBindingList()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 1, len(pbs))
+
+	pb, err := ovndbapi.PortBindingGetByLogicalPort(PB_LPORT)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, PB_LPORT, pb.LogicalPort)
+	assert.Equal(t, "", pb.Chassis)
+
+	pbByUUID, err := ovndbapi.PortBindingGet(pb.UUID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, pb, pbByUUID)
+
+	pbs, err = ovndbapi.PortBindingListByChassis(CHASSIS_NAME)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 0, len(pbs))
+
+	t.Logf("Binding %s to chassis %s", PB_LPORT, CHASSIS_NAME)
+	row := make(OVNRow)
+	row["chassis"] = stringToGoUUID(chassis[0].UUID)
+	operations := []libovsdb.Operation{{
+		Op:    opUpdate,
+		Table: TablePortBinding,
+		Row:   row,
+		Where: []interface{}{libovsdb.NewCondition("logical_port", "==", PB_LPORT)},
+	}}
+	err = ovndbapi.Execute(&OvnCommand{operations, ovndbapi.(*ovndb), make([][]map[string]interface{}, len(operations))})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pbs, err = ovndbapi.PortBindingListByChassis(CHASSIS_HOSTNAME)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 1, len(pbs))
+	assert.Equal(t, chassis[0].UUID, pbs[0].Chassis)
+
+	_, err = ovndbapi.PortBindingListByChassis(FAKENOCHASSIS)
+	assert.Equal(t, ErrorNotFound, err)
+
+	t.Logf("Deleting port binding %s", PB_LPORT)
+	delPortBinding(t, ovndbapi, PB_LPORT, PB_TUNNEL_KEY)
+	_, err = ovndbapi.PortBindingGetByLogicalPort(PB_LPORT)
+	assert.Equal(t, ErrorNotFound, err)
+
+	ocmd, err = ovndbapi.ChassisDel(CHASSIS_NAME)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(ocmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
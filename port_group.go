@@ -29,6 +29,7 @@ type PortGroup struct {
 	Ports      []string
 	ACLs       []string
 	ExternalID map[interface{}]interface{}
+	PopulatedColumns
 }
 
 func (odbi *ovndb) pgAddImp(group string, ports []string, external_ids map[string]string) (*OvnCommand, error) {
@@ -212,8 +213,8 @@ func (odbi *ovndb) RowToPortGroup(uuid string) *PortGroup {
 	}
 	pg := &PortGroup{
 		UUID:       uuid,
-		Name:       cachePortGroup.Fields["name"].(string),
-		ExternalID: cachePortGroup.Fields["external_ids"].(libovsdb.OvsMap).GoMap,
+		Name:       rowString(cachePortGroup, "name"),
+		ExternalID: rowMap(cachePortGroup, "external_ids"),
 	}
 	ports := cachePortGroup.Fields["ports"]
 	switch ports.(type) {
@@ -229,6 +230,7 @@ func (odbi *ovndb) RowToPortGroup(uuid string) *PortGroup {
 	case libovsdb.OvsSet:
 		pg.ACLs = odbi.ConvertGoSetToStringArray(acls.(libovsdb.OvsSet))
 	}
+	pg.PopulatedColumns = odbi.populated[TablePortGroup]
 	return pg
 }
 
@@ -0,0 +1,126 @@
+/**
+ * Copyright (c) 2020 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+// Package prommetrics implements goovn.Metrics with Prometheus metrics. The
+// Metrics are a prometheus.Collector to register with a registry.
+//
+//	m := prommetrics.New()
+//	prometheus.MustRegister(m)
+//	cfg.Metrics = m
+//	http.Handle("/metrics", promhttp.Handler())
+package prommetrics
+
+import (
+	"time"
+
+	goovn "github.com/ebay/go-ovn"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// DefaultBuckets are the upper bounds in seconds of the transact latency
+// histogram buckets
+var DefaultBuckets = prometheus.DefBuckets
+
+// Metrics collects the metrics of one or more clients, series are labeled
+// by db.
+type Metrics struct {
+	transact   *prometheus.HistogramVec
+	cacheRows  *prometheus.GaugeVec
+	updates    *prometheus.CounterVec
+	updateRows *prometheus.CounterVec
+	reconnects *prometheus.CounterVec
+}
+
+var (
+	_ goovn.Metrics        = &Metrics{}
+	_ prometheus.Collector = &Metrics{}
+)
+
+// New returns Metrics with the DefaultBuckets
+func New() *Metrics {
+	return NewWithBuckets(DefaultBuckets)
+}
+
+// NewWithBuckets returns Metrics with the given transact latency buckets in
+// seconds, in increasing order
+func NewWithBuckets(buckets []float64) *Metrics {
+	return &Metrics{
+		transact: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "goovn_transact_duration_seconds",
+			Help:    "Latency of transact requests by table, op and outcome.",
+			Buckets: buckets,
+		}, []string{"db", "table", "op", "outcome"}),
+		cacheRows: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "goovn_cache_rows",
+			Help: "Number of rows in the client cache.",
+		}, []string{"db", "table"}),
+		updates: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "goovn_updates_total",
+			Help: "Update notifications received per table.",
+		}, []string{"db", "table"}),
+		updateRows: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "goovn_update_rows_total",
+			Help: "Rows in the update notifications received.",
+		}, []string{"db", "table"}),
+		reconnects: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "goovn_reconnects_total",
+			Help: "Automatic reconnection attempts by outcome.",
+		}, []string{"db", "outcome"}),
+	}
+}
+
+func (m *Metrics) collectors() []prometheus.Collector {
+	return []prometheus.Collector{m.transact, m.cacheRows, m.updates, m.updateRows, m.reconnects}
+}
+
+// Describe implements prometheus.Collector
+func (m *Metrics) Describe(ch chan<- *prometheus.Desc) {
+	for _, c := range m.collectors() {
+		c.Describe(ch)
+	}
+}
+
+// Collect implements prometheus.Collector
+func (m *Metrics) Collect(ch chan<- prometheus.Metric) {
+	for _, c := range m.collectors() {
+		c.Collect(ch)
+	}
+}
+
+// TransactDone implements goovn.Metrics
+func (m *Metrics) TransactDone(db, table, op, outcome string, duration time.Duration) {
+	m.transact.WithLabelValues(db, table, op, outcome).Observe(duration.Seconds())
+}
+
+// CacheRows implements goovn.Metrics
+func (m *Metrics) CacheRows(db, table string, rows int) {
+	m.cacheRows.WithLabelValues(db, table).Set(float64(rows))
+}
+
+// UpdateReceived implements goovn.Metrics
+func (m *Metrics) UpdateReceived(db, table string, rows int) {
+	m.updates.WithLabelValues(db, table).Inc()
+	m.updateRows.WithLabelValues(db, table).Add(float64(rows))
+}
+
+// ReconnectAttempt implements goovn.Metrics
+func (m *Metrics) ReconnectAttempt(db string, success bool) {
+	outcome := "failure"
+	if success {
+		outcome = "success"
+	}
+	m.reconnects.WithLabelValues(db, outcome).Inc()
+}
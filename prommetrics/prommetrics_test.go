@@ -0,0 +1,66 @@
+/**
+ * Copyright (c) 2020 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package prommetrics
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	goovn "github.com/ebay/go-ovn"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetrics(t *testing.T) {
+	m := NewWithBuckets([]float64{0.01, 0.1})
+	reg := prometheus.NewPedanticRegistry()
+	reg.MustRegister(m)
+
+	m.TransactDone(goovn.DBNB, goovn.TableLogicalSwitch, "insert", goovn.TransactOutcomeSuccess, 5*time.Millisecond)
+	m.TransactDone(goovn.DBNB, goovn.TableLogicalSwitch, "insert", goovn.TransactOutcomeSuccess, 50*time.Millisecond)
+	m.CacheRows(goovn.DBNB, goovn.TableLogicalSwitch, 3)
+	m.CacheRows(goovn.DBNB, goovn.TableLogicalSwitch, 2)
+	m.UpdateReceived(goovn.DBNB, goovn.TableLogicalSwitch, 4)
+	m.ReconnectAttempt(goovn.DBNB, false)
+	m.ReconnectAttempt(goovn.DBNB, true)
+
+	expected := `
+# HELP goovn_cache_rows Number of rows in the client cache.
+# TYPE goovn_cache_rows gauge
+goovn_cache_rows{db="OVN_Northbound",table="Logical_Switch"} 2
+# HELP goovn_reconnects_total Automatic reconnection attempts by outcome.
+# TYPE goovn_reconnects_total counter
+goovn_reconnects_total{db="OVN_Northbound",outcome="failure"} 1
+goovn_reconnects_total{db="OVN_Northbound",outcome="success"} 1
+# HELP goovn_transact_duration_seconds Latency of transact requests by table, op and outcome.
+# TYPE goovn_transact_duration_seconds histogram
+goovn_transact_duration_seconds_bucket{db="OVN_Northbound",op="insert",outcome="success",table="Logical_Switch",le="0.01"} 1
+goovn_transact_duration_seconds_bucket{db="OVN_Northbound",op="insert",outcome="success",table="Logical_Switch",le="0.1"} 2
+goovn_transact_duration_seconds_bucket{db="OVN_Northbound",op="insert",outcome="success",table="Logical_Switch",le="+Inf"} 2
+goovn_transact_duration_seconds_sum{db="OVN_Northbound",op="insert",outcome="success",table="Logical_Switch"} 0.055
+goovn_transact_duration_seconds_count{db="OVN_Northbound",op="insert",outcome="success",table="Logical_Switch"} 2
+# HELP goovn_update_rows_total Rows in the update notifications received.
+# TYPE goovn_update_rows_total counter
+goovn_update_rows_total{db="OVN_Northbound",table="Logical_Switch"} 4
+# HELP goovn_updates_total Update notifications received per table.
+# TYPE goovn_updates_total counter
+goovn_updates_total{db="OVN_Northbound",table="Logical_Switch"} 1
+`
+	assert.NoError(t, testutil.GatherAndCompare(reg, strings.NewReader(expected)))
+}
@@ -32,6 +32,7 @@ type QoS struct {
 	Action     map[interface{}]interface{}
 	Bandwidth  map[interface{}]interface{}
 	ExternalID map[interface{}]interface{}
+	PopulatedColumns
 }
 
 func (odbi *ovndb) rowToQoS(uuid string) *QoS {
@@ -42,14 +43,15 @@ func (odbi *ovndb) rowToQoS(uuid string) *QoS {
 
 	qos := &QoS{
 		UUID:       uuid,
-		Priority:   cacheQoS.Fields["priority"].(int),
-		Direction:  cacheQoS.Fields["direction"].(string),
-		Match:      cacheQoS.Fields["match"].(string),
-		Action:     cacheQoS.Fields["action"].(libovsdb.OvsMap).GoMap,
-		Bandwidth:  cacheQoS.Fields["bandwidth"].(libovsdb.OvsMap).GoMap,
-		ExternalID: cacheQoS.Fields["external_ids"].(libovsdb.OvsMap).GoMap,
+		Priority:   rowInt(cacheQoS, "priority"),
+		Direction:  rowString(cacheQoS, "direction"),
+		Match:      rowString(cacheQoS, "match"),
+		Action:     rowMap(cacheQoS, "action"),
+		Bandwidth:  rowMap(cacheQoS, "bandwidth"),
+		ExternalID: rowMap(cacheQoS, "external_ids"),
 	}
 
+	qos.PopulatedColumns = odbi.populated[TableQoS]
 	return qos
 }
 
@@ -0,0 +1,770 @@
+/**
+ * Copyright (c) 2020 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ebay/libovsdb"
+)
+
+// Topology is the desired state of the logical switches, logical routers
+// and port groups of an owner. Reconcile makes the rows whose external_ids
+// map the owner key to Owner match it: missing rows are created, changed
+// columns are updated and owned rows that are not part of the topology are
+// deleted. Ports, ACLs, static routes and NATs of owned switches, routers and
+// port groups are fully managed by Reconcile.
+type Topology struct {
+	Owner      string
+	Switches   []LogicalSwitchSpec
+	Routers    []LogicalRouterSpec
+	PortGroups []PortGroupSpec
+}
+
+// LogicalSwitchSpec is the desired state of a logical switch
+type LogicalSwitchSpec struct {
+	Name        string            `ovsdb:"name"`
+	OtherConfig map[string]string `ovsdb:"other_config"`
+	ExternalIDs map[string]string `ovsdb:"external_ids"`
+	Ports       []LogicalSwitchPortSpec
+	ACLs        []ACLSpec
+}
+
+// LogicalSwitchPortSpec is the desired state of a logical switch port,
+// ports are matched by name
+type LogicalSwitchPortSpec struct {
+	Name         string            `ovsdb:"name"`
+	Type         string            `ovsdb:"type"`
+	Addresses    []string          `ovsdb:"addresses"`
+	PortSecurity []string          `ovsdb:"port_security"`
+	Options      map[string]string `ovsdb:"options"`
+	ExternalIDs  map[string]string `ovsdb:"external_ids"`
+}
+
+// ACLSpec is the desired state of an ACL, ACLs are matched by direction,
+// priority and match
+type ACLSpec struct {
+	Name        string            `ovsdb:"name"`
+	Direction   string            `ovsdb:"direction"`
+	Priority    int               `ovsdb:"priority"`
+	Match       string            `ovsdb:"match"`
+	Action      string            `ovsdb:"action"`
+	Log         bool              `ovsdb:"log"`
+	Severity    string            `ovsdb:"severity"`
+	Meter       string            `ovsdb:"meter"`
+	ExternalIDs map[string]string `ovsdb:"external_ids"`
+}
+
+// LogicalRouterSpec is the desired state of a logical router
+type LogicalRouterSpec struct {
+	Name         string            `ovsdb:"name"`
+	Options      map[string]string `ovsdb:"options"`
+	ExternalIDs  map[string]string `ovsdb:"external_ids"`
+	Ports        []LogicalRouterPortSpec
+	StaticRoutes []StaticRouteSpec
+	NATs         []NATSpec
+}
+
+// LogicalRouterPortSpec is the desired state of a logical router port,
+// ports are matched by name
+type LogicalRouterPortSpec struct {
+	Name        string            `ovsdb:"name"`
+	MAC         string            `ovsdb:"mac"`
+	Networks    []string          `ovsdb:"networks"`
+	Peer        string            `ovsdb:"peer"`
+	Options     map[string]string `ovsdb:"options"`
+	ExternalIDs map[string]string `ovsdb:"external_ids"`
+}
+
+// StaticRouteSpec is the desired state of a static route, routes are
+// matched by policy, IP prefix and nexthop
+type StaticRouteSpec struct {
+	IPPrefix    string            `ovsdb:"ip_prefix"`
+	Nexthop     string            `ovsdb:"nexthop"`
+	OutputPort  string            `ovsdb:"output_port"`
+	Policy      string            `ovsdb:"policy"`
+	ExternalIDs map[string]string `ovsdb:"external_ids"`
+}
+
+// NATSpec is the desired state of a NAT rule, rules are matched by type,
+// external IP and logical IP
+type NATSpec struct {
+	Type        string            `ovsdb:"type"`
+	ExternalIP  string            `ovsdb:"external_ip"`
+	ExternalMAC string            `ovsdb:"external_mac"`
+	LogicalIP   string            `ovsdb:"logical_ip"`
+	LogicalPort string            `ovsdb:"logical_port"`
+	ExternalIDs map[string]string `ovsdb:"external_ids"`
+}
+
+// PortGroupSpec is the desired state of a port group, Ports are the names
+// of logical switch ports of the topology or of any other switch
+type PortGroupSpec struct {
+	Name        string            `ovsdb:"name"`
+	ExternalIDs map[string]string `ovsdb:"external_ids"`
+	Ports       []string
+	ACLs        []ACLSpec
+}
+
+// reconcileSpec is the spec of a row that is owned by another row, e.g. a
+// logical switch port, its key identifies the row within its parent
+type reconcileSpec interface {
+	reconcileKey() string
+}
+
+func (s *LogicalSwitchPortSpec) reconcileKey() string { return s.Name }
+
+func (s *LogicalRouterPortSpec) reconcileKey() string { return s.Name }
+
+func (s *ACLSpec) reconcileKey() string {
+	return s.Direction + " " + strconv.Itoa(s.Priority) + " " + s.Match
+}
+
+func (s *StaticRouteSpec) reconcileKey() string {
+	policy := s.Policy
+	if policy == "" {
+		policy = "dst-ip"
+	}
+	return policy + " " + s.IPPrefix + " " + s.Nexthop
+}
+
+func (s *NATSpec) reconcileKey() string {
+	return s.Type + " " + s.ExternalIP + " " + s.LogicalIP
+}
+
+// ReconcileAction is the kind of a ReconcileChange
+type ReconcileAction string
+
+const (
+	ReconcileCreate ReconcileAction = "create"
+	ReconcileUpdate ReconcileAction = "update"
+	ReconcileDelete ReconcileAction = "delete"
+)
+
+// ReconcileChange is a row created, updated or deleted by Reconcile
+type ReconcileChange struct {
+	Action ReconcileAction
+	Table  string
+	// Name of the row, for ACLs, static routes and NATs the columns they
+	// are matched by
+	Name string
+	// Parent is the table and name of the row owning the row, e.g.
+	// "Logical_Switch ls1" for a logical switch port, "" for the rows of
+	// the topology itself
+	Parent string
+	// Columns updated by an update
+	Columns []string
+}
+
+func (c ReconcileChange) String() string {
+	sign := map[ReconcileAction]string{ReconcileCreate: "+", ReconcileUpdate: "~", ReconcileDelete: "-"}[c.Action]
+	s := fmt.Sprintf("%s %s %s", sign, c.Table, c.Name)
+	if c.Parent != "" {
+		s += " in " + c.Parent
+	}
+	if len(c.Columns) > 0 {
+		s += " (" + strings.Join(c.Columns, ", ") + ")"
+	}
+	return s
+}
+
+// ReconcileReport lists the changes made by Reconcile, or that would be made
+// for ReconcileDryRun. Rows of owned rows that are deleted, e.g. the ports
+// of a deleted switch, are garbage collected by the db server and are not
+// listed.
+type ReconcileReport struct {
+	Changes []ReconcileChange
+	// Transactions is the number of transactions the changes are made in
+	Transactions int
+}
+
+// String returns a diff-like report with a line per change
+func (r *ReconcileReport) String() string {
+	var b strings.Builder
+	for _, c := range r.Changes {
+		b.WriteString(c.String())
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+// reconciler plans the transactions that make the cache match a topology.
+// Each switch, router and port group with changes is created or updated in
+// a transaction of its own, owned rows that are not in the topology are
+// deleted by a last transaction.
+type reconciler struct {
+	odbi     *ovndb
+	ownerKey string
+	owner    string
+	changes  []ReconcileChange
+	batches  [][]libovsdb.Operation
+	ops      []libovsdb.Operation
+	// ports maps the names of the logical switch ports of the topology to
+	// their UUID, or their named UUID if they are inserted
+	ports map[string]libovsdb.UUID
+}
+
+func (r *reconciler) change(action ReconcileAction, table, name, parent string, columns ...string) {
+	r.changes = append(r.changes, ReconcileChange{action, table, name, parent, columns})
+}
+
+// flush ends the current transaction
+func (r *reconciler) flush() {
+	if len(r.ops) > 0 {
+		r.batches = append(r.batches, r.ops)
+		r.ops = nil
+	}
+}
+
+func (r *reconciler) isOwned(row libovsdb.Row) bool {
+	owner, ok := rowMap(row, "external_ids")[r.ownerKey].(string)
+	return ok && owner == r.owner
+}
+
+// ownedIDs returns a copy of externalIDs that maps the owner key to the owner
+func (r *reconciler) ownedIDs(externalIDs map[string]string) map[string]string {
+	ids := make(map[string]string, len(externalIDs)+1)
+	for k, v := range externalIDs {
+		ids[k] = v
+	}
+	ids[r.ownerKey] = r.owner
+	return ids
+}
+
+// findByName returns the UUID and row of table named name, "" if there is none
+func (r *reconciler) findByName(table, name string) (string, libovsdb.Row) {
+	for uuid, row := range r.odbi.cache[table] {
+		if rowString(row, "name") == name {
+			return uuid, row
+		}
+	}
+	return "", libovsdb.Row{}
+}
+
+// insert adds an insert of the row of spec and returns its named UUID
+func (r *reconciler) insert(table string, spec interface{}) (libovsdb.UUID, error) {
+	row, err := r.odbi.modelToRow(table, spec)
+	if err != nil {
+		return libovsdb.UUID{}, err
+	}
+	namedUUID, err := newRowUUID()
+	if err != nil {
+		return libovsdb.UUID{}, err
+	}
+	r.ops = append(r.ops, libovsdb.Operation{
+		Op:       opInsert,
+		Table:    table,
+		Row:      row,
+		UUIDName: namedUUID,
+	})
+	return stringToGoUUID(namedUUID), nil
+}
+
+// update adds an update of the columns of spec that differ from the row
+// with uuid and returns them
+func (r *reconciler) update(table, uuid string, row libovsdb.Row, spec interface{}) ([]string, error) {
+	current := reflect.New(reflect.TypeOf(spec).Elem()).Interface()
+	if err := rowToModel(row, uuid, current); err != nil {
+		return nil, fmt.Errorf("%s %s: %v", table, uuid, err)
+	}
+	columns, err := diffColumns(spec, current)
+	if err != nil || len(columns) == 0 {
+		return nil, err
+	}
+	update, err := r.odbi.modelToRow(table, spec, columns...)
+	if err != nil {
+		return nil, err
+	}
+	r.ops = append(r.ops, libovsdb.Operation{
+		Op:    opUpdate,
+		Table: table,
+		Row:   update,
+		Where: []interface{}{libovsdb.NewCondition(uuidColumn, "==", stringToGoUUID(uuid))},
+	})
+	return columns, nil
+}
+
+// topLevel creates or updates the owned row of table named name from spec.
+// It returns the UUID, or named UUID, of the row and the cached row if it
+// exists.
+func (r *reconciler) topLevel(table, name string, spec interface{}) (libovsdb.UUID, *libovsdb.Row, error) {
+	uuid, row := r.findByName(table, name)
+	if uuid == "" {
+		ref, err := r.insert(table, spec)
+		if err != nil {
+			return ref, nil, err
+		}
+		r.change(ReconcileCreate, table, name, "")
+		return ref, nil, nil
+	}
+	if !r.isOwned(row) {
+		return libovsdb.UUID{}, nil, fmt.Errorf("%s %s: %w", table, name, ErrorNotOwned)
+	}
+	columns, err := r.update(table, uuid, row, spec)
+	if err != nil {
+		return libovsdb.UUID{}, nil, err
+	}
+	if len(columns) > 0 {
+		r.change(ReconcileUpdate, table, name, "", columns...)
+	}
+	return stringToGoUUID(uuid), &row, nil
+}
+
+// children makes the rows of table referred to by column of the parent row
+// match desired. Rows are matched by the key of their spec, newSpec returns
+// a spec to decode the cached rows into. Named rows of tables with a unique
+// name must not exist outside of the parent. It returns the UUID, or named
+// UUID, of each desired row by key.
+func (r *reconciler) children(parentTable, parentName string, parentRef libovsdb.UUID, parentRow *libovsdb.Row,
+	column, table string, desired []reconcileSpec, newSpec func() reconcileSpec, uniqueName bool) (map[string]libovsdb.UUID, error) {
+	parent := parentTable + " " + parentName
+
+	current := make(map[string]string)
+	var stale []string
+	if parentRow != nil {
+		for _, uuid := range rowSet(*parentRow, column) {
+			row, ok := r.odbi.cache[table][uuid]
+			if !ok {
+				continue
+			}
+			spec := newSpec()
+			if err := rowToModel(row, uuid, spec); err != nil {
+				return nil, fmt.Errorf("%s %s: %v", table, uuid, err)
+			}
+			key := spec.reconcileKey()
+			if _, ok := current[key]; ok {
+				// a duplicate that would match the same desired row
+				stale = append(stale, uuid)
+				continue
+			}
+			current[key] = uuid
+		}
+	}
+
+	refs := make(map[string]libovsdb.UUID, len(desired))
+	var inserted, deleted []interface{}
+	for _, spec := range desired {
+		key := spec.reconcileKey()
+		if _, ok := refs[key]; ok {
+			return nil, fmt.Errorf("%s %q of %s is given twice", table, key, parent)
+		}
+		uuid, ok := current[key]
+		if !ok {
+			if uniqueName {
+				if other, _ := r.findByName(table, key); other != "" {
+					return nil, fmt.Errorf("%s %s is not a row of %s: %w", table, key, parent, ErrorNotOwned)
+				}
+			}
+			ref, err := r.insert(table, spec)
+			if err != nil {
+				return nil, err
+			}
+			refs[key] = ref
+			inserted = append(inserted, ref)
+			r.change(ReconcileCreate, table, key, parent)
+			continue
+		}
+		columns, err := r.update(table, uuid, r.odbi.cache[table][uuid], spec)
+		if err != nil {
+			return nil, err
+		}
+		if len(columns) > 0 {
+			r.change(ReconcileUpdate, table, key, parent, columns...)
+		}
+		refs[key] = stringToGoUUID(uuid)
+	}
+
+	var keys []string
+	for key := range current {
+		if _, ok := refs[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		deleted = append(deleted, stringToGoUUID(current[key]))
+		r.change(ReconcileDelete, table, key, parent)
+	}
+	for _, uuid := range stale {
+		deleted = append(deleted, stringToGoUUID(uuid))
+	}
+	r.mutateSet(parentTable, parentRef, column, inserted, deleted)
+	return refs, nil
+}
+
+// mutateSet adds a mutation inserting and deleting the elements of the set
+// column of the row with ref. Rows no longer referred to are garbage
+// collected by the db server.
+func (r *reconciler) mutateSet(table string, ref libovsdb.UUID, column string, inserted, deleted []interface{}) {
+	var mutations []interface{}
+	if len(inserted) > 0 {
+		mutations = append(mutations, libovsdb.NewMutation(column, opInsert, libovsdb.OvsSet{GoSet: inserted}))
+	}
+	if len(deleted) > 0 {
+		mutations = append(mutations, libovsdb.NewMutation(column, opDelete, libovsdb.OvsSet{GoSet: deleted}))
+	}
+	if len(mutations) == 0 {
+		return
+	}
+	r.ops = append(r.ops, libovsdb.Operation{
+		Op:        opMutate,
+		Table:     table,
+		Mutations: mutations,
+		Where:     []interface{}{libovsdb.NewCondition(uuidColumn, "==", ref)},
+	})
+}
+
+func (r *reconciler) logicalSwitch(s LogicalSwitchSpec) error {
+	s.ExternalIDs = r.ownedIDs(s.ExternalIDs)
+	ref, row, err := r.topLevel(TableLogicalSwitch, s.Name, &s)
+	if err != nil {
+		return err
+	}
+	ports := make([]reconcileSpec, len(s.Ports))
+	for i := range s.Ports {
+		ports[i] = &s.Ports[i]
+	}
+	refs, err := r.children(TableLogicalSwitch, s.Name, ref, row, "ports", TableLogicalSwitchPort, ports,
+		func() reconcileSpec { return &LogicalSwitchPortSpec{} }, true)
+	if err != nil {
+		return err
+	}
+	for name, ref := range refs {
+		r.ports[name] = ref
+	}
+	if err := r.acls(TableLogicalSwitch, s.Name, ref, row, s.ACLs); err != nil {
+		return err
+	}
+	r.flush()
+	return nil
+}
+
+func (r *reconciler) acls(parentTable, parentName string, parentRef libovsdb.UUID, parentRow *libovsdb.Row, acls []ACLSpec) error {
+	specs := make([]reconcileSpec, len(acls))
+	for i := range acls {
+		specs[i] = &acls[i]
+	}
+	_, err := r.children(parentTable, parentName, parentRef, parentRow, "acls", TableACL, specs,
+		func() reconcileSpec { return &ACLSpec{} }, false)
+	return err
+}
+
+func (r *reconciler) logicalRouter(s LogicalRouterSpec) error {
+	s.ExternalIDs = r.ownedIDs(s.ExternalIDs)
+	ref, row, err := r.topLevel(TableLogicalRouter, s.Name, &s)
+	if err != nil {
+		return err
+	}
+	ports := make([]reconcileSpec, len(s.Ports))
+	for i := range s.Ports {
+		ports[i] = &s.Ports[i]
+	}
+	if _, err := r.children(TableLogicalRouter, s.Name, ref, row, "ports", TableLogicalRouterPort, ports,
+		func() reconcileSpec { return &LogicalRouterPortSpec{} }, true); err != nil {
+		return err
+	}
+	routes := make([]reconcileSpec, len(s.StaticRoutes))
+	for i := range s.StaticRoutes {
+		routes[i] = &s.StaticRoutes[i]
+	}
+	if _, err := r.children(TableLogicalRouter, s.Name, ref, row, "static_routes", TableLogicalRouterStaticRoute, routes,
+		func() reconcileSpec { return &StaticRouteSpec{} }, false); err != nil {
+		return err
+	}
+	nats := make([]reconcileSpec, len(s.NATs))
+	for i := range s.NATs {
+		nats[i] = &s.NATs[i]
+	}
+	if _, err := r.children(TableLogicalRouter, s.Name, ref, row, "nat", TableNAT, nats,
+		func() reconcileSpec { return &NATSpec{} }, false); err != nil {
+		return err
+	}
+	r.flush()
+	return nil
+}
+
+func (r *reconciler) portGroup(s PortGroupSpec) error {
+	s.ExternalIDs = r.ownedIDs(s.ExternalIDs)
+	ref, row, err := r.topLevel(TablePortGroup, s.Name, &s)
+	if err != nil {
+		return err
+	}
+
+	wanted := make(map[libovsdb.UUID]bool, len(s.Ports))
+	var inserted, deleted []interface{}
+	var current []string
+	if row != nil {
+		current = rowSet(*row, "ports")
+	}
+	has := make(map[string]bool, len(current))
+	for _, uuid := range current {
+		has[uuid] = true
+	}
+	for _, name := range s.Ports {
+		port, ok := r.ports[name]
+		if !ok {
+			uuid, _ := r.findByName(TableLogicalSwitchPort, name)
+			if uuid == "" {
+				return fmt.Errorf("port %s of %s %s: %w", name, TablePortGroup, s.Name, ErrorNotFound)
+			}
+			port = stringToGoUUID(uuid)
+		}
+		if wanted[port] {
+			continue
+		}
+		wanted[port] = true
+		if !has[port.GoUUID] {
+			inserted = append(inserted, port)
+		}
+	}
+	for _, uuid := range current {
+		if !wanted[stringToGoUUID(uuid)] {
+			deleted = append(deleted, stringToGoUUID(uuid))
+		}
+	}
+	if len(inserted) > 0 || len(deleted) > 0 {
+		if row != nil {
+			r.change(ReconcileUpdate, TablePortGroup, s.Name, "", "ports")
+		}
+		r.mutateSet(TablePortGroup, ref, "ports", inserted, deleted)
+	}
+
+	if err := r.acls(TablePortGroup, s.Name, ref, row, s.ACLs); err != nil {
+		return err
+	}
+	r.flush()
+	return nil
+}
+
+// deleteUnwanted deletes the owned rows of table that are not named in wanted
+func (r *reconciler) deleteUnwanted(table string, wanted map[string]bool) {
+	var names []string
+	uuids := make(map[string]string)
+	for uuid, row := range r.odbi.cache[table] {
+		name := rowString(row, "name")
+		if r.isOwned(row) && !wanted[name] {
+			names = append(names, name)
+			uuids[name] = uuid
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		r.ops = append(r.ops, libovsdb.Operation{
+			Op:    opDelete,
+			Table: table,
+			Where: []interface{}{libovsdb.NewCondition(uuidColumn, "==", stringToGoUUID(uuids[name]))},
+		})
+		r.change(ReconcileDelete, table, name, "")
+	}
+}
+
+// plan computes the changes and transactions that make the cache match
+// desired
+func (r *reconciler) plan(desired *Topology) error {
+	r.odbi.cachemutex.RLock()
+	defer r.odbi.cachemutex.RUnlock()
+
+	for _, table := range []string{TableLogicalSwitch, TableLogicalSwitchPort, TableACL, TableLogicalRouter,
+		TableLogicalRouterPort, TableLogicalRouterStaticRoute, TableNAT, TablePortGroup} {
+		if r.odbi.tableColumnTypes(table) == nil {
+			return ErrorSchema
+		}
+	}
+
+	switches := make(map[string]bool, len(desired.Switches))
+	for _, s := range desired.Switches {
+		if switches[s.Name] {
+			return fmt.Errorf("%s %s is given twice", TableLogicalSwitch, s.Name)
+		}
+		switches[s.Name] = true
+		if err := r.logicalSwitch(s); err != nil {
+			return err
+		}
+	}
+	routers := make(map[string]bool, len(desired.Routers))
+	for _, s := range desired.Routers {
+		if routers[s.Name] {
+			return fmt.Errorf("%s %s is given twice", TableLogicalRouter, s.Name)
+		}
+		routers[s.Name] = true
+		if err := r.logicalRouter(s); err != nil {
+			return err
+		}
+	}
+	groups := make(map[string]bool, len(desired.PortGroups))
+	for _, s := range desired.PortGroups {
+		if groups[s.Name] {
+			return fmt.Errorf("%s %s is given twice", TablePortGroup, s.Name)
+		}
+		groups[s.Name] = true
+		if err := r.portGroup(s); err != nil {
+			return err
+		}
+	}
+	r.deleteUnwanted(TablePortGroup, groups)
+	r.deleteUnwanted(TableLogicalRouter, routers)
+	r.deleteUnwanted(TableLogicalSwitch, switches)
+	r.flush()
+	return nil
+}
+
+// resolveNamedUUIDs replaces the named UUIDs of rows inserted by earlier
+// transactions in the rows and mutations of ops
+func resolveNamedUUIDs(ops []libovsdb.Operation, uuids map[string]string) {
+	resolve := func(value interface{}) interface{} {
+		switch v := value.(type) {
+		case libovsdb.UUID:
+			if uuid, ok := uuids[v.GoUUID]; ok {
+				return stringToGoUUID(uuid)
+			}
+		case libovsdb.OvsSet:
+			elems := make([]interface{}, len(v.GoSet))
+			for i, elem := range v.GoSet {
+				elems[i] = elem
+				if u, ok := elem.(libovsdb.UUID); ok {
+					if uuid, ok := uuids[u.GoUUID]; ok {
+						elems[i] = stringToGoUUID(uuid)
+					}
+				}
+			}
+			return libovsdb.OvsSet{GoSet: elems}
+		}
+		return value
+	}
+	for i := range ops {
+		for column, value := range ops[i].Row {
+			ops[i].Row[column] = resolve(value)
+		}
+		for _, m := range ops[i].Mutations {
+			if mutation, ok := m.([]interface{}); ok && len(mutation) == 3 {
+				mutation[2] = resolve(mutation[2])
+			}
+		}
+		for _, c := range ops[i].Where {
+			if cond, ok := c.([]interface{}); ok && len(cond) == 3 {
+				cond[2] = resolve(cond[2])
+			}
+		}
+	}
+}
+
+func (odbi *ovndb) reconcilePlan(desired *Topology, ownerKey string) (*reconciler, error) {
+	if odbi.db != DBNB {
+		return nil, ErrorOption
+	}
+	if desired == nil || desired.Owner == "" || ownerKey == "" {
+		return nil, ErrorOption
+	}
+	r := &reconciler{
+		odbi:     odbi,
+		ownerKey: ownerKey,
+		owner:    desired.Owner,
+		ports:    make(map[string]libovsdb.UUID),
+	}
+	if err := r.plan(desired); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (odbi *ovndb) reconcileDryRunImp(desired *Topology, ownerKey string) (*ReconcileReport, error) {
+	r, err := odbi.reconcilePlan(desired, ownerKey)
+	if err != nil {
+		return nil, err
+	}
+	return &ReconcileReport{Changes: r.changes, Transactions: len(r.batches)}, nil
+}
+
+// reconcileImp executes the transactions of the plan in order. If one fails
+// the report lists all planned changes and Transactions the number of
+// transactions that were committed.
+func (odbi *ovndb) reconcileImp(ctx context.Context, desired *Topology, ownerKey string) (*ReconcileReport, error) {
+	res, err := readContext(ctx, func() (interface{}, error) { return odbi.reconcilePlan(desired, ownerKey) })
+	if err != nil {
+		return nil, err
+	}
+	r := res.(*reconciler)
+	report := &ReconcileReport{Changes: r.changes}
+	uuids := make(map[string]string)
+	for _, ops := range r.batches {
+		resolveNamedUUIDs(ops, uuids)
+		results, err := odbi.transactContext(ctx, odbi.db, ops...)
+		if err != nil {
+			return report, err
+		}
+		for i, op := range ops {
+			if op.UUIDName != "" && i < len(results) {
+				uuids[op.UUIDName] = results[i].UUID.GoUUID
+			}
+		}
+		report.Transactions++
+	}
+	return report, nil
+}
+
+// diffColumns returns the columns of the model desired whose value differs
+// from the model current. Sets are compared regardless of order and empty
+// sets and maps equal nil ones.
+func diffColumns(desired, current interface{}) ([]string, error) {
+	dv, info, err := modelValue(desired)
+	if err != nil {
+		return nil, err
+	}
+	cv := reflect.ValueOf(current).Elem()
+	var columns []string
+	for _, f := range info.fields {
+		if !valuesEqual(dv.FieldByIndex(f.index), cv.FieldByIndex(f.index)) {
+			columns = append(columns, f.column)
+		}
+	}
+	return columns, nil
+}
+
+func valuesEqual(a, b reflect.Value) bool {
+	switch a.Kind() {
+	case reflect.Slice:
+		if a.Len() != b.Len() {
+			return false
+		}
+		as := make([]string, a.Len())
+		bs := make([]string, b.Len())
+		for i := range as {
+			as[i] = fmt.Sprint(a.Index(i).Interface())
+			bs[i] = fmt.Sprint(b.Index(i).Interface())
+		}
+		sort.Strings(as)
+		sort.Strings(bs)
+		return reflect.DeepEqual(as, bs)
+	case reflect.Map:
+		if a.Len() != b.Len() {
+			return false
+		}
+		for _, k := range a.MapKeys() {
+			bv := b.MapIndex(k)
+			if !bv.IsValid() || !reflect.DeepEqual(a.MapIndex(k).Interface(), bv.Interface()) {
+				return false
+			}
+		}
+		return true
+	}
+	return reflect.DeepEqual(a.Interface(), b.Interface())
+}
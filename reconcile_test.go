@@ -0,0 +1,198 @@
+/**
+ * Copyright (c) 2020 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	goovn "github.com/ebay/go-ovn"
+	"github.com/ebay/go-ovn/goovntest"
+	"github.com/stretchr/testify/assert"
+)
+
+const reconcileOwnerKey = "reconcile-test"
+
+func reconcileTopology() *goovn.Topology {
+	return &goovn.Topology{
+		Owner: "tenant1",
+		Switches: []goovn.LogicalSwitchSpec{{
+			Name: "ls1",
+			Ports: []goovn.LogicalSwitchPortSpec{
+				{Name: "p1", Addresses: []string{"00:00:00:00:00:01 10.0.0.1"}},
+				{Name: "p2", Addresses: []string{"00:00:00:00:00:02 10.0.0.2"}},
+				{Name: "ls1-lr1", Type: "router", Addresses: []string{"router"}, Options: map[string]string{"router-port": "lrp1"}},
+			},
+			ACLs: []goovn.ACLSpec{
+				{Direction: "to-lport", Priority: 1001, Match: "tcp.dst == 22", Action: "drop"},
+			},
+		}},
+		Routers: []goovn.LogicalRouterSpec{{
+			Name: "lr1",
+			Ports: []goovn.LogicalRouterPortSpec{
+				{Name: "lrp1", MAC: "00:00:00:00:01:01", Networks: []string{"10.0.0.254/24"}},
+			},
+			StaticRoutes: []goovn.StaticRouteSpec{
+				{IPPrefix: "0.0.0.0/0", Nexthop: "10.0.0.253"},
+			},
+			NATs: []goovn.NATSpec{
+				{Type: "snat", ExternalIP: "192.168.0.1", LogicalIP: "10.0.0.0/24"},
+			},
+		}},
+		PortGroups: []goovn.PortGroupSpec{{
+			Name:  "pg1",
+			Ports: []string{"p1", "p2"},
+			ACLs: []goovn.ACLSpec{
+				{Direction: "from-lport", Priority: 1000, Match: "ip4", Action: "allow-related"},
+			},
+		}},
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	ovndbapi := goovntest.NewClient(t, goovn.DBNB)
+	ctx := context.Background()
+
+	desired := reconcileTopology()
+	report, err := ovndbapi.ReconcileDryRun(desired, reconcileOwnerKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 3, report.Transactions)
+	assert.Len(t, report.Changes, 11)
+	assert.Contains(t, report.String(), "+ Logical_Switch_Port p1 in Logical_Switch ls1\n")
+	assert.Contains(t, report.String(), "+ NAT snat 192.168.0.1 10.0.0.0/24 in Logical_Router lr1\n")
+	// A dry run writes nothing
+	_, err = ovndbapi.LSPGet("p1")
+	assert.NotNil(t, err)
+
+	report, err = ovndbapi.Reconcile(ctx, desired, reconcileOwnerKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 3, report.Transactions)
+	lss, err := ovndbapi.LSGet("ls1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "tenant1", lss[0].ExternalID[reconcileOwnerKey])
+	lsps, err := ovndbapi.LSPList("ls1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Len(t, lsps, 3)
+	p1, err := ovndbapi.LSPGet("p1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []string{"00:00:00:00:00:01 10.0.0.1"}, p1.Addresses)
+	acls, err := ovndbapi.ACLList("ls1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Len(t, acls, 1)
+	routes, err := ovndbapi.LRSRList("lr1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Len(t, routes, 1)
+	nats, err := ovndbapi.LRNATList("lr1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Len(t, nats, 1)
+	pg, err := ovndbapi.PortGroupGet("pg1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Len(t, pg.Ports, 2)
+	assert.Contains(t, pg.Ports, p1.UUID)
+	assert.Len(t, pg.ACLs, 1)
+
+	// Nothing to do once the db matches
+	report, err = ovndbapi.Reconcile(ctx, desired, reconcileOwnerKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Empty(t, report.Changes)
+	assert.Equal(t, 0, report.Transactions)
+
+	// Only the changed rows and columns are written
+	desired.Switches[0].Ports[0].Addresses = []string{"00:00:00:00:00:01 10.0.0.11"}
+	desired.Switches[0].Ports = append(desired.Switches[0].Ports[:1], desired.Switches[0].Ports[2],
+		goovn.LogicalSwitchPortSpec{Name: "p3", Addresses: []string{"dynamic"}})
+	desired.Routers[0].StaticRoutes = nil
+	desired.PortGroups[0].Ports = []string{"p1", "p3"}
+	report, err = ovndbapi.ReconcileDryRun(desired, reconcileOwnerKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "~ Logical_Switch_Port p1 in Logical_Switch ls1 (addresses)\n"+
+		"+ Logical_Switch_Port p3 in Logical_Switch ls1\n"+
+		"- Logical_Switch_Port p2 in Logical_Switch ls1\n"+
+		"- Logical_Router_Static_Route dst-ip 0.0.0.0/0 10.0.0.253 in Logical_Router lr1\n"+
+		"~ Port_Group pg1 (ports)\n", report.String())
+	report, err = ovndbapi.Reconcile(ctx, desired, reconcileOwnerKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 3, report.Transactions)
+	p1, err = ovndbapi.LSPGet("p1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []string{"00:00:00:00:00:01 10.0.0.11"}, p1.Addresses)
+	_, err = ovndbapi.LSPGet("p2")
+	assert.Equal(t, goovn.ErrorNotFound, err)
+	p3, err := ovndbapi.LSPGet("p3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	routes, _ = ovndbapi.LRSRList("lr1")
+	assert.Empty(t, routes)
+	pg, err = ovndbapi.PortGroupGet("pg1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.ElementsMatch(t, []string{p1.UUID, p3.UUID}, pg.Ports)
+
+	// Rows of other owners are left alone and cannot be taken over
+	cmd, err := ovndbapi.LSAdd("other")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = ovndbapi.Execute(cmd); err != nil {
+		t.Fatal(err)
+	}
+	desired.Switches = append(desired.Switches, goovn.LogicalSwitchSpec{Name: "other"})
+	_, err = ovndbapi.Reconcile(ctx, desired, reconcileOwnerKey)
+	assert.True(t, errors.Is(err, goovn.ErrorNotOwned))
+
+	// Owned rows that are no longer desired are deleted
+	desired.Switches = desired.Switches[:1]
+	desired.Routers = nil
+	report, err = ovndbapi.Reconcile(ctx, desired, reconcileOwnerKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []goovn.ReconcileChange{{Action: goovn.ReconcileDelete, Table: goovn.TableLogicalRouter, Name: "lr1"}}, report.Changes)
+	lrs, _ := ovndbapi.LRList()
+	assert.Empty(t, lrs)
+	_, err = ovndbapi.LSGet("other")
+	assert.Nil(t, err)
+}
@@ -0,0 +1,123 @@
+/**
+ * Copyright (c) 2020 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn
+
+import (
+	"math/rand"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// DefaultReconnectInitialBackoff is the delay before the first
+	// reconnection attempt if ReconnectPolicy.InitialBackoff is not set
+	DefaultReconnectInitialBackoff = 500 * time.Millisecond
+	// DefaultReconnectMaxBackoff is the longest delay between reconnection
+	// attempts if ReconnectPolicy.MaxBackoff is not set
+	DefaultReconnectMaxBackoff = 30 * time.Second
+)
+
+// ReconnectPolicy controls the reconnection of a client with Config.Reconnect.
+// The delay before each attempt starts at InitialBackoff and doubles after
+// every failed attempt up to MaxBackoff.
+type ReconnectPolicy struct {
+	InitialBackoff time.Duration // DefaultReconnectInitialBackoff if 0
+	MaxBackoff     time.Duration // DefaultReconnectMaxBackoff if 0
+	Jitter         float64       // Fraction by which each delay is randomly varied, e.g. 0.2 for +/-20%
+	MaxAttempts    int           // Give up and call DisconnectCB after this many failed attempts, retry forever if 0
+}
+
+func (p ReconnectPolicy) withDefaults() ReconnectPolicy {
+	if p.InitialBackoff <= 0 {
+		p.InitialBackoff = DefaultReconnectInitialBackoff
+	}
+	if p.MaxBackoff <= 0 {
+		p.MaxBackoff = DefaultReconnectMaxBackoff
+	}
+	if p.MaxBackoff < p.InitialBackoff {
+		p.MaxBackoff = p.InitialBackoff
+	}
+	return p
+}
+
+// delay returns backoff varied by the jitter of the policy
+func (p ReconnectPolicy) delay(backoff time.Duration) time.Duration {
+	if p.Jitter <= 0 {
+		return backoff
+	}
+	return time.Duration(float64(backoff) * (1 + p.Jitter*(2*rand.Float64()-1)))
+}
+
+// nextBackoff doubles backoff up to the max backoff of the policy
+func (p ReconnectPolicy) nextBackoff(backoff time.Duration) time.Duration {
+	backoff *= 2
+	if backoff > p.MaxBackoff {
+		return p.MaxBackoff
+	}
+	return backoff
+}
+
+func (c *ovndb) setConnected(connected bool) {
+	var v int32
+	if connected {
+		v = 1
+	}
+	atomic.StoreInt32(&c.connected, v)
+}
+
+func (c *ovndb) isClosed() bool {
+	return atomic.LoadInt32(&c.closed) == 1
+}
+
+func (c *ovndb) reconnect() {
+	go func() {
+		c.logger.Info("disconnected, reconnecting", "addr", c.addr)
+		policy := c.reconnectPolicy.withDefaults()
+		backoff := policy.InitialBackoff
+		var err error
+		for attempt := 1; ; attempt++ {
+			if policy.MaxAttempts > 0 && attempt > policy.MaxAttempts {
+				c.logger.Error(err, "reconnect failed, giving up", "addr", c.addr, "attempts", policy.MaxAttempts)
+				if c.disconnectCB != nil {
+					c.disconnectCB()
+				}
+				return
+			}
+			if c.reconnectingCB != nil {
+				c.reconnectingCB(attempt, err)
+			}
+			time.Sleep(policy.delay(backoff))
+			if c.isClosed() {
+				return
+			}
+			err = connect(c)
+			if c.metrics != nil {
+				c.metrics.ReconnectAttempt(c.db, err == nil)
+			}
+			if err != nil {
+				c.logger.Error(err, "reconnect attempt failed, retrying", "addr", c.addr, "attempt", attempt)
+				backoff = policy.nextBackoff(backoff)
+				continue
+			}
+			c.logger.Info("reconnected", "addr", c.addr, "attempts", attempt)
+			if c.reconnectedCB != nil {
+				c.reconnectedCB(attempt)
+			}
+			return
+		}
+	}()
+}
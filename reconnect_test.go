@@ -0,0 +1,61 @@
+/**
+ * Copyright (c) 2020 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReconnectPolicy(t *testing.T) {
+	p := ReconnectPolicy{}.withDefaults()
+	assert.Equal(t, DefaultReconnectInitialBackoff, p.InitialBackoff)
+	assert.Equal(t, DefaultReconnectMaxBackoff, p.MaxBackoff)
+
+	p = ReconnectPolicy{InitialBackoff: time.Second, MaxBackoff: 3 * time.Second, Jitter: 0.5}.withDefaults()
+	backoff := p.InitialBackoff
+	backoff = p.nextBackoff(backoff)
+	assert.Equal(t, 2*time.Second, backoff)
+	backoff = p.nextBackoff(backoff)
+	assert.Equal(t, 3*time.Second, backoff)
+	for i := 0; i < 100; i++ {
+		delay := p.delay(backoff)
+		assert.True(t, delay >= 1500*time.Millisecond && delay <= 4500*time.Millisecond)
+	}
+}
+
+func TestClientConnected(t *testing.T) {
+	cfg := buildOvnDbConfig(DBNB)
+	connected := false
+	cfg.OnConnected = func() {
+		connected = true
+	}
+	api, err := NewClient(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.True(t, connected)
+	assert.True(t, api.Connected())
+
+	api.Close()
+	for i := 0; i < 50 && api.Connected(); i++ {
+		time.Sleep(100 * time.Millisecond)
+	}
+	assert.False(t, api.Connected())
+}
@@ -23,6 +23,8 @@ type SBGlobalTableRow struct {
 	Connections []string
 	SSL         string
 	IPSec       bool
+	NbCfg       int
+	PopulatedColumns
 }
 
 func (odbi *ovndb) sbGlobalAddImp(options map[string]string) (*OvnCommand, error) {
@@ -43,3 +45,38 @@ func (odbi *ovndb) sbGlobalSetOptionsImp(options map[string]string) (*OvnCommand
 func (odbi *ovndb) sbGlobalGetOptionsImp() (map[string]string, error) {
 	return odbi.globalGetOptionsImp(TableSBGlobal)
 }
+
+func (odbi *ovndb) sbGlobalGetImp() (*SBGlobalTableRow, error) {
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+
+	cacheSBGlobal, ok := odbi.cache[TableSBGlobal]
+	if !ok {
+		return nil, ErrorSchema
+	}
+	for uuid := range cacheSBGlobal {
+		return odbi.rowToSBGlobal(uuid), nil
+	}
+	return nil, ErrorNotFound
+}
+
+func (odbi *ovndb) rowToSBGlobal(uuid string) *SBGlobalTableRow {
+	cacheSBGlobal, ok := odbi.cache[TableSBGlobal][uuid]
+	if !ok {
+		return nil
+	}
+
+	sbGlobal := &SBGlobalTableRow{
+		UUID:        uuid,
+		Options:     rowMap(cacheSBGlobal, "options"),
+		ExternalID:  rowMap(cacheSBGlobal, "external_ids"),
+		Connections: rowSet(cacheSBGlobal, "connections"),
+		IPSec:       rowBool(cacheSBGlobal, "ipsec"),
+		NbCfg:       rowInt(cacheSBGlobal, "nb_cfg"),
+	}
+	if ssl := rowSet(cacheSBGlobal, "ssl"); len(ssl) > 0 {
+		sbGlobal.SSL = ssl[0]
+	}
+	sbGlobal.PopulatedColumns = odbi.populated[TableSBGlobal]
+	return sbGlobal
+}
@@ -19,6 +19,7 @@
 package goovn
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -75,6 +76,14 @@ func TestSBGlobalAPI(t *testing.T) {
 	assert.Equal(t, ok, true)
 	assert.Equal(t, val, SB_GLOBAL_OPTIONS_1_VAL)
 
+	sbGlobal, err := ovndbapi.SBGlobalGet()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, SB_GLOBAL_OPTIONS_1_VAL, sbGlobal.Options[SB_GLOBAL_OPTIONS_1_KEY])
+	err = ovndbapi.WaitForSync(context.Background(), SyncSB)
+	assert.Equal(t, ErrorOption, err)
+
 	t.Logf("Deleting row from SB_Global table in OVN")
 	cmd, err = ovn.sbGlobalDel()
 	if err != nil {
@@ -0,0 +1,124 @@
+/**
+ * Copyright (c) 2020 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn
+
+import (
+	"github.com/ebay/libovsdb"
+)
+
+const (
+	atomicInteger string = "integer"
+	atomicReal    string = "real"
+	atomicBoolean string = "boolean"
+	atomicString  string = "string"
+	atomicUUID    string = "uuid"
+)
+
+// unlimited is used as columnType.max for "max": "unlimited"
+const unlimited = -1
+
+// columnType is the parsed form of an RFC 7047 <type> from the db schema
+type columnType struct {
+	key      string
+	value    string
+	refTable string
+	min      int
+	max      int
+}
+
+func parseBaseType(base interface{}) (string, string) {
+	switch b := base.(type) {
+	case string:
+		return b, ""
+	case map[string]interface{}:
+		atomic, _ := b["type"].(string)
+		refTable, _ := b["refTable"].(string)
+		return atomic, refTable
+	}
+	return "", ""
+}
+
+func parseColumnType(t interface{}) columnType {
+	ct := columnType{min: 1, max: 1}
+	switch v := t.(type) {
+	case string:
+		ct.key = v
+	case map[string]interface{}:
+		ct.key, ct.refTable = parseBaseType(v["key"])
+		if value, ok := v["value"]; ok {
+			ct.value, _ = parseBaseType(value)
+		}
+		if min, ok := v["min"].(float64); ok {
+			ct.min = int(min)
+		}
+		switch max := v["max"].(type) {
+		case float64:
+			ct.max = int(max)
+		case string:
+			if max == "unlimited" {
+				ct.max = unlimited
+			}
+		}
+	}
+	return ct
+}
+
+func (ct columnType) isMap() bool {
+	return ct.value != ""
+}
+
+func (ct columnType) isSet() bool {
+	return !ct.isMap() && !(ct.min == 1 && ct.max == 1)
+}
+
+// defaultValue returns the value ovsdb-server would report for a column
+// that was not given a value on insert.
+func (ct columnType) defaultValue() interface{} {
+	if ct.isMap() {
+		return libovsdb.OvsMap{GoMap: make(map[interface{}]interface{})}
+	}
+	if ct.isSet() {
+		if ct.min == 0 {
+			return libovsdb.OvsSet{}
+		}
+	}
+	switch ct.key {
+	case atomicInteger:
+		return 0
+	case atomicReal:
+		return float64(0)
+	case atomicBoolean:
+		return false
+	case atomicUUID:
+		return stringToGoUUID("00000000-0000-0000-0000-000000000000")
+	}
+	return ""
+}
+
+// tableColumnTypes returns the parsed column types of table from the schema
+// of the connected db, or nil if the table is unknown.
+func (odbi *ovndb) tableColumnTypes(table string) map[string]columnType {
+	tableSchema, ok := odbi.GetSchema().Tables[table]
+	if !ok {
+		return nil
+	}
+	columns := make(map[string]columnType, len(tableSchema.Columns))
+	for name, column := range tableSchema.Columns {
+		columns[name] = parseColumnType(column.Type)
+	}
+	return columns
+}
@@ -0,0 +1,207 @@
+/**
+ * Copyright (c) 2020 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn_test
+
+import (
+	"testing"
+	"time"
+
+	goovn "github.com/ebay/go-ovn"
+	"github.com/ebay/go-ovn/goovntest"
+	"github.com/ebay/libovsdb"
+	"github.com/stretchr/testify/assert"
+)
+
+// baselineSignal implements only OVNSignal, like the implementations that
+// predate the optional signal interfaces
+type baselineSignal struct{}
+
+var _ goovn.OVNSignal = baselineSignal{}
+
+func (baselineSignal) OnLogicalSwitchCreate(ls *goovn.LogicalSwitch)                         {}
+func (baselineSignal) OnLogicalSwitchDelete(ls *goovn.LogicalSwitch)                         {}
+func (baselineSignal) OnLogicalSwitchUpdate(old, new *goovn.LogicalSwitch)                   {}
+func (baselineSignal) OnLogicalPortCreate(lp *goovn.LogicalSwitchPort)                       {}
+func (baselineSignal) OnLogicalPortDelete(lp *goovn.LogicalSwitchPort)                       {}
+func (baselineSignal) OnLogicalPortUpdate(old, new *goovn.LogicalSwitchPort)                 {}
+func (baselineSignal) OnLogicalRouterCreate(lr *goovn.LogicalRouter)                         {}
+func (baselineSignal) OnLogicalRouterDelete(lr *goovn.LogicalRouter)                         {}
+func (baselineSignal) OnLogicalRouterUpdate(old, new *goovn.LogicalRouter)                   {}
+func (baselineSignal) OnLogicalRouterPortCreate(lrp *goovn.LogicalRouterPort)                {}
+func (baselineSignal) OnLogicalRouterPortDelete(lrp *goovn.LogicalRouterPort)                {}
+func (baselineSignal) OnLogicalRouterPortUpdate(old, new *goovn.LogicalRouterPort)           {}
+func (baselineSignal) OnLogicalRouterStaticRouteCreate(lrsr *goovn.LogicalRouterStaticRoute) {}
+func (baselineSignal) OnLogicalRouterStaticRouteDelete(lrsr *goovn.LogicalRouterStaticRoute) {}
+func (baselineSignal) OnACLCreate(acl *goovn.ACL)                                            {}
+func (baselineSignal) OnACLDelete(acl *goovn.ACL)                                            {}
+func (baselineSignal) OnACLUpdate(old, new *goovn.ACL)                                       {}
+func (baselineSignal) OnDHCPOptionsCreate(dhcp *goovn.DHCPOptions)                           {}
+func (baselineSignal) OnDHCPOptionsDelete(dhcp *goovn.DHCPOptions)                           {}
+func (baselineSignal) OnDHCPOptionsUpdate(old, new *goovn.DHCPOptions)                       {}
+func (baselineSignal) OnQoSCreate(qos *goovn.QoS)                                            {}
+func (baselineSignal) OnQoSDelete(qos *goovn.QoS)                                            {}
+func (baselineSignal) OnQoSUpdate(old, new *goovn.QoS)                                       {}
+func (baselineSignal) OnLoadBalancerCreate(ls *goovn.LoadBalancer)                           {}
+func (baselineSignal) OnLoadBalancerDelete(ls *goovn.LoadBalancer)                           {}
+func (baselineSignal) OnMeterCreate(meter *goovn.Meter)                                      {}
+func (baselineSignal) OnMeterDelete(meter *goovn.Meter)                                      {}
+func (baselineSignal) OnMeterUpdate(old, new *goovn.Meter)                                   {}
+func (baselineSignal) OnMeterBandCreate(band *goovn.MeterBand)                               {}
+func (baselineSignal) OnMeterBandDelete(band *goovn.MeterBand)                               {}
+func (baselineSignal) OnMeterBandUpdate(old, new *goovn.MeterBand)                           {}
+func (baselineSignal) OnChassisCreate(ch *goovn.Chassis)                                     {}
+func (baselineSignal) OnChassisDelete(ch *goovn.Chassis)                                     {}
+func (baselineSignal) OnChassisUpdate(old, new *goovn.Chassis)                               {}
+func (baselineSignal) OnEncapCreate(ch *goovn.Encap)                                         {}
+func (baselineSignal) OnEncapDelete(ch *goovn.Encap)                                         {}
+func (baselineSignal) OnEncapUpdate(old, new *goovn.Encap)                                   {}
+
+type portBindingSignal struct {
+	baselineSignal
+	events chan string
+}
+
+var _ goovn.OVNPortBindingSignal = portBindingSignal{}
+
+func (s portBindingSignal) OnPortBindingCreate(pb *goovn.PortBinding) {
+	s.events <- "create " + pb.LogicalPort
+}
+
+func (s portBindingSignal) OnPortBindingUpdate(old, new *goovn.PortBinding) {
+	if !old.Up && new.Up {
+		s.events <- "up " + new.LogicalPort
+	}
+}
+
+func (s portBindingSignal) OnPortBindingDelete(pb *goovn.PortBinding) {
+	s.events <- "delete " + pb.LogicalPort
+}
+
+// createSignal implements only OVNSignal and reports the logical switch
+// creations
+type createSignal struct {
+	baselineSignal
+	events chan string
+}
+
+func (s createSignal) OnLogicalSwitchCreate(ls *goovn.LogicalSwitch) {
+	v, _ := ls.ExternalID["k"].(string)
+	s.events <- "create " + ls.Name + " " + v
+}
+
+type updaterSignal struct {
+	baselineSignal
+	events chan string
+}
+
+var _ goovn.OVNSignalUpdater = updaterSignal{}
+
+func (s updaterSignal) OnLogicalSwitchUpdate(old, new *goovn.LogicalSwitch) {
+	s.events <- "update " + new.Name + " " + new.ExternalID["k"].(string)
+}
+
+func (updaterSignal) OnLogicalPortUpdate(old, new *goovn.LogicalSwitchPort)                     {}
+func (updaterSignal) OnLogicalRouterUpdate(old, new *goovn.LogicalRouter)                       {}
+func (updaterSignal) OnLogicalRouterPortUpdate(old, new *goovn.LogicalRouterPort)               {}
+func (updaterSignal) OnLogicalRouterStaticRouteUpdate(old, new *goovn.LogicalRouterStaticRoute) {}
+func (updaterSignal) OnACLUpdate(old, new *goovn.ACL)                                           {}
+func (updaterSignal) OnDHCPOptionsUpdate(old, new *goovn.DHCPOptions)                           {}
+func (updaterSignal) OnQoSUpdate(old, new *goovn.QoS)                                           {}
+func (updaterSignal) OnLoadBalancerUpdate(old, new *goovn.LoadBalancer)                         {}
+func (updaterSignal) OnMeterUpdate(old, new *goovn.Meter)                                       {}
+func (updaterSignal) OnMeterBandUpdate(old, new *goovn.MeterBand)                               {}
+func (updaterSignal) OnChassisUpdate(old, new *goovn.Chassis)                                   {}
+func (updaterSignal) OnEncapUpdate(old, new *goovn.Encap)                                       {}
+
+// nextEvent returns the next event sent to events or fails the test
+func nextEvent(t *testing.T, events chan string) string {
+	t.Helper()
+	select {
+	case event := <-events:
+		return event
+	case <-time.After(5 * time.Second):
+		t.Fatal("no event")
+	}
+	return ""
+}
+
+func execute(t *testing.T, c goovn.Client, ops ...libovsdb.Operation) {
+	t.Helper()
+	if err := c.Execute(&goovn.OvnCommand{Operations: ops}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPortBindingSignal(t *testing.T) {
+	sig := portBindingSignal{events: make(chan string, 3)}
+	cfg := &goovn.Config{Db: goovn.DBSB, SignalCB: sig}
+	goovntest.NewClientWithConfig(t, cfg)
+	// An OVNSignal without the Port_Binding callbacks is not notified of them
+	other, err := goovn.NewClient(&goovn.Config{Db: goovn.DBSB, Addr: cfg.Addr, SignalCB: baselineSignal{}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer other.Close()
+
+	lp1 := []interface{}{libovsdb.NewCondition("logical_port", "==", "lp1")}
+	execute(t, other,
+		libovsdb.Operation{Op: "insert", Table: goovn.TableDatapathBinding, UUIDName: "dp",
+			Row: map[string]interface{}{"tunnel_key": 1}},
+		libovsdb.Operation{Op: "insert", Table: goovn.TablePortBinding,
+			Row: map[string]interface{}{"logical_port": "lp1", "tunnel_key": 1, "datapath": libovsdb.UUID{GoUUID: "dp"}}},
+	)
+	assert.Equal(t, "create lp1", nextEvent(t, sig.events))
+	execute(t, other, libovsdb.Operation{Op: "update", Table: goovn.TablePortBinding, Where: lp1,
+		Row: map[string]interface{}{"up": true}})
+	assert.Equal(t, "up lp1", nextEvent(t, sig.events))
+	execute(t, other, libovsdb.Operation{Op: "delete", Table: goovn.TablePortBinding, Where: lp1})
+	assert.Equal(t, "delete lp1", nextEvent(t, sig.events))
+}
+
+func TestSignalUpdater(t *testing.T) {
+	sig := updaterSignal{events: make(chan string, 1)}
+	c := goovntest.NewClientWithConfig(t, &goovn.Config{Db: goovn.DBNB, SignalCB: sig})
+
+	execute(t, c, libovsdb.Operation{Op: "insert", Table: goovn.TableLogicalSwitch,
+		Row: map[string]interface{}{"name": "ls1"}})
+	extIDs, err := libovsdb.NewOvsMap(map[string]string{"k": "v"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	execute(t, c, libovsdb.Operation{Op: "mutate", Table: goovn.TableLogicalSwitch,
+		Where:     []interface{}{libovsdb.NewCondition("name", "==", "ls1")},
+		Mutations: []interface{}{libovsdb.NewMutation("external_ids", "insert", extIDs)}})
+	assert.Equal(t, "update ls1 v", nextEvent(t, sig.events))
+}
+
+func TestSignalModificationAsCreate(t *testing.T) {
+	sig := createSignal{events: make(chan string, 2)}
+	c := goovntest.NewClientWithConfig(t, &goovn.Config{Db: goovn.DBNB, SignalCB: sig})
+
+	execute(t, c, libovsdb.Operation{Op: "insert", Table: goovn.TableLogicalSwitch,
+		Row: map[string]interface{}{"name": "ls1"}})
+	assert.Equal(t, "create ls1 ", nextEvent(t, sig.events))
+	extIDs, err := libovsdb.NewOvsMap(map[string]string{"k": "v"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	execute(t, c, libovsdb.Operation{Op: "mutate", Table: goovn.TableLogicalSwitch,
+		Where:     []interface{}{libovsdb.NewCondition("name", "==", "ls1")},
+		Mutations: []interface{}{libovsdb.NewMutation("external_ids", "insert", extIDs)}})
+	// a callback without OVNSignalUpdater gets the modification as a creation
+	assert.Equal(t, "create ls1 v", nextEvent(t, sig.events))
+}
@@ -0,0 +1,773 @@
+/**
+ * Copyright (c) 2020 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ebay/libovsdb"
+	"github.com/google/uuid"
+	"gopkg.in/yaml.v2"
+)
+
+// SnapshotFormatVersion is the version of the Snapshot format
+const SnapshotFormatVersion = 1
+
+// DefaultImportBatchSize is the number of rows Import writes per transaction
+// if no batch size is given
+const DefaultImportBatchSize = 1000
+
+// Encodings of Snapshot.Encode
+const (
+	SnapshotJSON = "json"
+	SnapshotYAML = "yaml"
+)
+
+// snapshotSingletons are the tables with a single row that Import updates
+// if the database already has it
+var snapshotSingletons = map[string]bool{TableNBGlobal: true, TableSBGlobal: true}
+
+// Snapshot is a serializable copy of the monitored tables of a database.
+//
+// Rows are identified by symbolic names instead of UUIDs, references are
+// the name of the referred row. The name of a row is the value of its name
+// column if the table has one and the value is unique and not empty,
+// otherwise it is "#<n>" for the nth row of the table in the order of
+// column values. References to rows that are not in the snapshot, e.g. of
+// tables that are not monitored, keep their UUID.
+type Snapshot struct {
+	Format        int                      `json:"format" yaml:"format"`
+	Database      string                   `json:"database" yaml:"database"`
+	SchemaVersion string                   `json:"schema_version" yaml:"schema_version"`
+	Tables        map[string][]SnapshotRow `json:"tables" yaml:"tables"`
+}
+
+// SnapshotRow is a row of a Snapshot. Columns holds the columns that do not
+// have their default value: atoms for scalar columns, lists for sets and
+// objects with string keys for maps.
+type SnapshotRow struct {
+	Name    string                 `json:"name" yaml:"name"`
+	Columns map[string]interface{} `json:"columns,omitempty" yaml:"columns,omitempty"`
+}
+
+// Encode writes the snapshot in the encoding, SnapshotJSON or SnapshotYAML
+func (s *Snapshot) Encode(w io.Writer, encoding string) error {
+	switch encoding {
+	case SnapshotJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(s)
+	case SnapshotYAML:
+		b, err := yaml.Marshal(s)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(b)
+		return err
+	}
+	return ErrorOption
+}
+
+// DecodeSnapshot reads a snapshot written by Snapshot.Encode in either
+// encoding
+func DecodeSnapshot(r io.Reader) (*Snapshot, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	s := &Snapshot{}
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("{")) {
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.UseNumber()
+		err = dec.Decode(s)
+	} else {
+		err = yaml.Unmarshal(data, s)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if s.Format != SnapshotFormatVersion {
+		return nil, fmt.Errorf("unsupported snapshot format %d", s.Format)
+	}
+	return s, nil
+}
+
+// snapshotAtom returns the snapshot value of an atom, the name of the
+// referred row for a UUID. All references are "" if names is nil.
+func snapshotAtom(value interface{}, atomic string, names map[string]string) interface{} {
+	switch v := value.(type) {
+	case libovsdb.UUID:
+		if names == nil {
+			return ""
+		}
+		if name, ok := names[v.GoUUID]; ok {
+			return name
+		}
+		return v.GoUUID
+	case float64:
+		if atomic == atomicInteger {
+			return int(v)
+		}
+	}
+	return value
+}
+
+// snapshotValue returns the snapshot value of a cached column value, or nil
+// if it is the default value of the column
+func snapshotValue(value interface{}, ct columnType, names map[string]string) interface{} {
+	if ct.isMap() {
+		m, ok := value.(libovsdb.OvsMap)
+		if !ok || len(m.GoMap) == 0 {
+			return nil
+		}
+		res := make(map[string]interface{}, len(m.GoMap))
+		for k, v := range m.GoMap {
+			res[fmt.Sprint(snapshotAtom(k, ct.key, names))] = snapshotAtom(v, ct.value, names)
+		}
+		return res
+	}
+	var elems []interface{}
+	if s, ok := value.(libovsdb.OvsSet); ok {
+		elems = s.GoSet
+	} else {
+		elems = []interface{}{value}
+	}
+	if ct.isSet() {
+		if len(elems) == 0 {
+			return nil
+		}
+		res := make([]interface{}, len(elems))
+		for i, elem := range elems {
+			res[i] = snapshotAtom(elem, ct.key, names)
+		}
+		sort.Slice(res, func(i, j int) bool { return fmt.Sprint(res[i]) < fmt.Sprint(res[j]) })
+		return res
+	}
+	if len(elems) != 1 {
+		return nil
+	}
+	atom := snapshotAtom(elems[0], ct.key, names)
+	switch atom {
+	case "", 0, 0.0, false:
+		return nil
+	}
+	return atom
+}
+
+// snapshotColumns returns the snapshot values of the columns of row
+func snapshotColumns(row libovsdb.Row, columnTypes map[string]columnType, names map[string]string) map[string]interface{} {
+	columns := make(map[string]interface{})
+	for column, value := range row.Fields {
+		ct, ok := columnTypes[column]
+		if !ok || strings.HasPrefix(column, "_") {
+			continue
+		}
+		if v := snapshotValue(value, ct, names); v != nil {
+			columns[column] = v
+		}
+	}
+	return columns
+}
+
+// rowRefs returns the UUIDs referred to by row
+func rowRefs(row libovsdb.Row) []string {
+	var refs []string
+	add := func(v interface{}) {
+		if u, ok := v.(libovsdb.UUID); ok {
+			refs = append(refs, u.GoUUID)
+		}
+	}
+	for _, value := range row.Fields {
+		switch v := value.(type) {
+		case libovsdb.OvsSet:
+			for _, elem := range v.GoSet {
+				add(elem)
+			}
+		case libovsdb.OvsMap:
+			for k, elem := range v.GoMap {
+				add(k)
+				add(elem)
+			}
+		default:
+			add(v)
+		}
+	}
+	return refs
+}
+
+// snapshotNames returns the symbolic names of the cached rows of tables by UUID
+func (odbi *ovndb) snapshotNames(tables []string) map[string]string {
+	names := make(map[string]string)
+	for _, table := range tables {
+		ct, ok := odbi.tableColumnTypes(table)["name"]
+		if !ok || ct.key != atomicString || ct.isMap() || ct.isSet() {
+			continue
+		}
+		count := make(map[string]int)
+		for _, row := range odbi.cache[table] {
+			count[rowString(row, "name")]++
+		}
+		for uuid, row := range odbi.cache[table] {
+			name := rowString(row, "name")
+			if name != "" && !strings.HasPrefix(name, "#") && count[name] == 1 {
+				names[uuid] = name
+			}
+		}
+	}
+
+	// The other rows are ordered by their columns, with references left
+	// out, and then by the rows referring to them
+	referrers := make(map[string][]string)
+	for _, table := range tables {
+		for uuid, row := range odbi.cache[table] {
+			referrer := table + " " + names[uuid]
+			for _, ref := range rowRefs(row) {
+				referrers[ref] = append(referrers[ref], referrer)
+			}
+		}
+	}
+	for _, table := range tables {
+		columnTypes := odbi.tableColumnTypes(table)
+		type unnamed struct {
+			uuid, key string
+		}
+		var rows []unnamed
+		for uuid, row := range odbi.cache[table] {
+			if _, ok := names[uuid]; ok {
+				continue
+			}
+			sort.Strings(referrers[uuid])
+			key, _ := json.Marshal([]interface{}{snapshotColumns(row, columnTypes, nil), referrers[uuid]})
+			rows = append(rows, unnamed{uuid, string(key)})
+		}
+		sort.Slice(rows, func(i, j int) bool {
+			if rows[i].key != rows[j].key {
+				return rows[i].key < rows[j].key
+			}
+			return rows[i].uuid < rows[j].uuid
+		})
+		for i, row := range rows {
+			names[row.uuid] = "#" + strconv.Itoa(i+1)
+		}
+	}
+	return names
+}
+
+func (odbi *ovndb) snapshotImp() (*Snapshot, error) {
+	schema := odbi.GetSchema()
+	var tables []string
+	for table := range odbi.tableCols {
+		tables = append(tables, table)
+	}
+	sort.Strings(tables)
+
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+
+	names := odbi.snapshotNames(tables)
+	s := &Snapshot{
+		Format:        SnapshotFormatVersion,
+		Database:      schema.Name,
+		SchemaVersion: schema.Version,
+		Tables:        make(map[string][]SnapshotRow, len(tables)),
+	}
+	for _, table := range tables {
+		columnTypes := odbi.tableColumnTypes(table)
+		rows := make([]SnapshotRow, 0, len(odbi.cache[table]))
+		for uuid, row := range odbi.cache[table] {
+			rows = append(rows, SnapshotRow{Name: names[uuid], Columns: snapshotColumns(row, columnTypes, names)})
+		}
+		sort.Slice(rows, func(i, j int) bool { return snapshotNameLess(rows[i].Name, rows[j].Name) })
+		s.Tables[table] = rows
+	}
+	return s, nil
+}
+
+// snapshotNameLess orders rows by name, numbered rows last in order
+func snapshotNameLess(a, b string) bool {
+	an, aNumbered := snapshotNumber(a)
+	bn, bNumbered := snapshotNumber(b)
+	switch {
+	case aNumbered && bNumbered:
+		return an < bn
+	case aNumbered != bNumbered:
+		return bNumbered
+	}
+	return a < b
+}
+
+func snapshotNumber(name string) (int, bool) {
+	if !strings.HasPrefix(name, "#") {
+		return 0, false
+	}
+	n, err := strconv.Atoi(name[1:])
+	return n, err == nil
+}
+
+// importRow is a row of a snapshot being imported
+type importRow struct {
+	table   string
+	name    string
+	columns map[string]interface{}
+	refs    []int // the rows referred to
+	group   int
+	// uuid is the UUID of the row once written, or of the singleton row
+	// it updates
+	uuid string
+}
+
+// importer writes the rows of a snapshot in batched transactions. Each row
+// that no other row refers to starts a group with the rows it refers to
+// directly or indirectly, so that rows of non-root tables are inserted in
+// the same transaction as a row referring to them. Groups are written
+// after the groups they refer to, groups that refer to each other are
+// merged.
+type importer struct {
+	odbi   *ovndb
+	rows   []*importRow
+	byName map[string]int // row index by table and name
+}
+
+func importKey(table, name string) string {
+	return table + "\x00" + name
+}
+
+// lookup returns the index of the row of table named by the snapshot value v
+func (im *importer) lookup(table string, v interface{}) (int, bool) {
+	name, ok := v.(string)
+	if !ok {
+		return 0, false
+	}
+	i, ok := im.byName[importKey(table, name)]
+	return i, ok
+}
+
+// eachRef calls f with the referred table and snapshot value of each
+// reference of the row
+func (im *importer) eachRef(row *importRow, f func(refTable string, v interface{})) {
+	columnTypes := im.odbi.tableColumnTypes(row.table)
+	for column, value := range row.columns {
+		ct := columnTypes[column]
+		if ct.refTable == "" && !(ct.isMap() && ct.value == atomicUUID) {
+			continue
+		}
+		switch v := value.(type) {
+		case []interface{}:
+			for _, elem := range v {
+				f(ct.refTable, elem)
+			}
+		case map[string]interface{}:
+			if ct.value == atomicUUID {
+				for _, elem := range v {
+					f("", elem)
+				}
+			}
+		default:
+			f(ct.refTable, v)
+		}
+	}
+}
+
+// group assigns the rows to groups and returns the groups in the order
+// they are written
+func (im *importer) group() [][]int {
+	referred := make([]bool, len(im.rows))
+	for _, row := range im.rows {
+		for _, ref := range row.refs {
+			referred[ref] = true
+		}
+	}
+	for _, row := range im.rows {
+		row.group = -1
+	}
+	var groups [][]int
+	assign := func(root int) {
+		g := len(groups)
+		groups = append(groups, nil)
+		stack := []int{root}
+		im.rows[root].group = g
+		for len(stack) > 0 {
+			i := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			groups[g] = append(groups[g], i)
+			for _, ref := range im.rows[i].refs {
+				if im.rows[ref].group < 0 {
+					im.rows[ref].group = g
+					stack = append(stack, ref)
+				}
+			}
+		}
+	}
+	for i := range im.rows {
+		if !referred[i] && im.rows[i].group < 0 {
+			assign(i)
+		}
+	}
+	for i := range im.rows {
+		if im.rows[i].group < 0 {
+			// only referred to by rows in a cycle
+			assign(i)
+		}
+	}
+
+	// Tarjan's algorithm emits the strongly connected components of the
+	// dependency graph of groups with the groups they depend on first
+	deps := make([]map[int]bool, len(groups))
+	for g, members := range groups {
+		deps[g] = make(map[int]bool)
+		for _, i := range members {
+			for _, ref := range im.rows[i].refs {
+				if h := im.rows[ref].group; h != g {
+					deps[g][h] = true
+				}
+			}
+		}
+	}
+	index := make([]int, len(groups))
+	low := make([]int, len(groups))
+	onStack := make([]bool, len(groups))
+	for g := range index {
+		index[g] = -1
+	}
+	var stack []int
+	var ordered [][]int
+	next := 0
+	var visit func(g int)
+	visit = func(g int) {
+		index[g], low[g] = next, next
+		next++
+		stack = append(stack, g)
+		onStack[g] = true
+		var hs []int
+		for h := range deps[g] {
+			hs = append(hs, h)
+		}
+		sort.Ints(hs)
+		for _, h := range hs {
+			if index[h] < 0 {
+				visit(h)
+				if low[h] < low[g] {
+					low[g] = low[h]
+				}
+			} else if onStack[h] && index[h] < low[g] {
+				low[g] = index[h]
+			}
+		}
+		if low[g] == index[g] {
+			var merged []int
+			for {
+				h := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				onStack[h] = false
+				merged = append(merged, groups[h]...)
+				if h == g {
+					break
+				}
+			}
+			ordered = append(ordered, merged)
+		}
+	}
+	for g := range groups {
+		if index[g] < 0 {
+			visit(g)
+		}
+	}
+	return ordered
+}
+
+// importAtom returns the ovsdb value of the snapshot atom v of the atomic
+// type, ref returns the UUID of a referred row
+func importAtom(v interface{}, atomic, refTable string, ref func(refTable string, v interface{}) (libovsdb.UUID, error)) (interface{}, error) {
+	switch atomic {
+	case atomicUUID:
+		return ref(refTable, v)
+	case atomicString:
+		if s, ok := v.(string); ok {
+			return s, nil
+		}
+	case atomicBoolean:
+		switch b := v.(type) {
+		case bool:
+			return b, nil
+		case string:
+			return strconv.ParseBool(b)
+		}
+	case atomicInteger:
+		switch n := v.(type) {
+		case int:
+			return n, nil
+		case float64:
+			if n == float64(int(n)) {
+				return int(n), nil
+			}
+		case json.Number:
+			i, err := n.Int64()
+			return int(i), err
+		case string:
+			return strconv.Atoi(n)
+		}
+	case atomicReal:
+		switch n := v.(type) {
+		case int:
+			return float64(n), nil
+		case float64:
+			return n, nil
+		case json.Number:
+			return n.Float64()
+		case string:
+			return strconv.ParseFloat(n, 64)
+		}
+	}
+	return nil, fmt.Errorf("invalid %s %v (%T)", atomic, v, v)
+}
+
+// importValue returns the ovsdb value of the snapshot value v of a column
+func importValue(v interface{}, ct columnType, ref func(refTable string, v interface{}) (libovsdb.UUID, error)) (interface{}, error) {
+	if ct.isMap() {
+		m := make(map[interface{}]interface{})
+		add := func(k, e interface{}) error {
+			key, err := importAtom(fmt.Sprint(k), ct.key, "", ref)
+			if err != nil {
+				return err
+			}
+			value, err := importAtom(e, ct.value, "", ref)
+			if err != nil {
+				return err
+			}
+			m[key] = value
+			return nil
+		}
+		switch x := v.(type) {
+		case map[string]interface{}:
+			for k, e := range x {
+				if err := add(k, e); err != nil {
+					return nil, err
+				}
+			}
+		case map[interface{}]interface{}:
+			for k, e := range x {
+				if err := add(k, e); err != nil {
+					return nil, err
+				}
+			}
+		default:
+			return nil, fmt.Errorf("invalid map %v (%T)", v, v)
+		}
+		if len(m) == 0 {
+			return emptyMap, nil
+		}
+		return libovsdb.OvsMap{GoMap: m}, nil
+	}
+	if !ct.isSet() {
+		return importAtom(v, ct.key, ct.refTable, ref)
+	}
+	elems, ok := v.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("invalid set %v (%T)", v, v)
+	}
+	set := make([]interface{}, 0, len(elems))
+	for _, elem := range elems {
+		atom, err := importAtom(elem, ct.key, ct.refTable, ref)
+		if err != nil {
+			return nil, err
+		}
+		set = append(set, atom)
+	}
+	return libovsdb.OvsSet{GoSet: set}, nil
+}
+
+// prepare checks the snapshot against the schema and the cache and builds
+// the rows to import
+func (im *importer) prepare(s *Snapshot) error {
+	schema := im.odbi.GetSchema()
+	if s.Format != SnapshotFormatVersion {
+		return fmt.Errorf("unsupported snapshot format %d", s.Format)
+	}
+	if s.Database != schema.Name {
+		return fmt.Errorf("snapshot of %s cannot be imported into %s: %w", s.Database, schema.Name, ErrorOption)
+	}
+	if major(s.SchemaVersion) != major(schema.Version) {
+		return fmt.Errorf("snapshot of schema version %s cannot be imported into schema version %s: %w",
+			s.SchemaVersion, schema.Version, ErrorSchema)
+	}
+
+	var tables []string
+	for table := range s.Tables {
+		tables = append(tables, table)
+	}
+	sort.Strings(tables)
+
+	im.odbi.cachemutex.RLock()
+	defer im.odbi.cachemutex.RUnlock()
+
+	im.byName = make(map[string]int)
+	for _, table := range tables {
+		columnTypes := im.odbi.tableColumnTypes(table)
+		if columnTypes == nil {
+			return fmt.Errorf("table %s: %w", table, ErrorSchema)
+		}
+		rows := s.Tables[table]
+		if len(rows) == 0 {
+			continue
+		}
+		existing := im.odbi.cache[table]
+		singleton := ""
+		if len(existing) > 0 {
+			if !snapshotSingletons[table] || len(rows) != 1 || len(existing) != 1 {
+				return fmt.Errorf("table %s is not empty: %w", table, ErrorExist)
+			}
+			for uuid := range existing {
+				singleton = uuid
+			}
+		}
+		for _, r := range rows {
+			for column := range r.Columns {
+				if _, ok := columnTypes[column]; !ok {
+					return fmt.Errorf("column %s not found in table %s: %w", column, table, ErrorSchema)
+				}
+			}
+			key := importKey(table, r.Name)
+			if _, ok := im.byName[key]; ok {
+				return fmt.Errorf("row %s of table %s is given twice", r.Name, table)
+			}
+			im.byName[key] = len(im.rows)
+			im.rows = append(im.rows, &importRow{table: table, name: r.Name, columns: r.Columns, uuid: singleton})
+		}
+	}
+	for _, row := range im.rows {
+		im.eachRef(row, func(refTable string, v interface{}) {
+			if i, ok := im.lookup(refTable, v); ok {
+				row.refs = append(row.refs, i)
+			}
+		})
+	}
+	return nil
+}
+
+func major(version string) string {
+	return strings.SplitN(version, ".", 2)[0]
+}
+
+// operation returns the insert of the row, or the update of the singleton
+// row it replaces. named holds the named UUIDs of the rows of the batch.
+func (im *importer) operation(row *importRow, named map[*importRow]string) (libovsdb.Operation, error) {
+	ref := func(refTable string, v interface{}) (libovsdb.UUID, error) {
+		if i, ok := im.lookup(refTable, v); ok {
+			target := im.rows[i]
+			if target.uuid != "" {
+				return stringToGoUUID(target.uuid), nil
+			}
+			if name, ok := named[target]; ok {
+				return stringToGoUUID(name), nil
+			}
+		}
+		if s, ok := v.(string); ok {
+			if _, err := uuid.Parse(s); err == nil {
+				// a reference to a row that is not in the snapshot
+				return stringToGoUUID(s), nil
+			}
+		}
+		return libovsdb.UUID{}, fmt.Errorf("row %v of table %s: %w", v, refTable, ErrorNotFound)
+	}
+	columnTypes := im.odbi.tableColumnTypes(row.table)
+	ovnRow := make(OVNRow, len(row.columns))
+	for column, v := range row.columns {
+		value, err := importValue(v, columnTypes[column], ref)
+		if err != nil {
+			return libovsdb.Operation{}, fmt.Errorf("row %s of table %s column %s: %v", row.name, row.table, column, err)
+		}
+		ovnRow[column] = value
+	}
+	if row.uuid != "" {
+		return libovsdb.Operation{
+			Op:    opUpdate,
+			Table: row.table,
+			Row:   ovnRow,
+			Where: []interface{}{libovsdb.NewCondition(uuidColumn, "==", stringToGoUUID(row.uuid))},
+		}, nil
+	}
+	return libovsdb.Operation{
+		Op:       opInsert,
+		Table:    row.table,
+		Row:      ovnRow,
+		UUIDName: named[row],
+	}, nil
+}
+
+// write imports the rows of the batch in a transaction
+func (im *importer) write(ctx context.Context, batch []int) error {
+	named := make(map[*importRow]string)
+	for _, i := range batch {
+		if row := im.rows[i]; row.uuid == "" {
+			name, err := newRowUUID()
+			if err != nil {
+				return err
+			}
+			named[row] = name
+		}
+	}
+	ops := make([]libovsdb.Operation, 0, len(batch))
+	for _, i := range batch {
+		op, err := im.operation(im.rows[i], named)
+		if err != nil {
+			return err
+		}
+		ops = append(ops, op)
+	}
+	results, err := im.odbi.transactContext(ctx, im.odbi.db, ops...)
+	if err != nil {
+		return err
+	}
+	for k, i := range batch {
+		if row := im.rows[i]; row.uuid == "" && k < len(results) {
+			row.uuid = results[k].UUID.GoUUID
+		}
+	}
+	return nil
+}
+
+func (odbi *ovndb) importImp(ctx context.Context, s *Snapshot, batchSize int) error {
+	if batchSize <= 0 {
+		batchSize = DefaultImportBatchSize
+	}
+	im := &importer{odbi: odbi}
+	if _, err := readContext(ctx, func() (interface{}, error) { return nil, im.prepare(s) }); err != nil {
+		return err
+	}
+	var batch []int
+	for _, group := range im.group() {
+		if len(batch) > 0 && len(batch)+len(group) > batchSize {
+			if err := im.write(ctx, batch); err != nil {
+				return err
+			}
+			batch = nil
+		}
+		batch = append(batch, group...)
+	}
+	if len(batch) > 0 {
+		return im.write(ctx, batch)
+	}
+	return nil
+}
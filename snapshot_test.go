@@ -0,0 +1,127 @@
+/**
+ * Copyright (c) 2020 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	goovn "github.com/ebay/go-ovn"
+	"github.com/ebay/go-ovn/goovntest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSnapshot(t *testing.T) {
+	ctx := context.Background()
+	src := goovntest.NewClient(t, goovn.DBNB)
+
+	global := &goovn.Snapshot{
+		Format:        goovn.SnapshotFormatVersion,
+		Database:      goovn.DBNB,
+		SchemaVersion: src.GetSchema().Version,
+		Tables: map[string][]goovn.SnapshotRow{
+			goovn.TableNBGlobal: {{Name: "#1"}},
+		},
+	}
+	if err := src.Import(ctx, global, 0); err != nil {
+		t.Fatal(err)
+	}
+	cmd, err := src.NBGlobalSetOptions(map[string]string{"mac_prefix": "0a:00:00"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = src.Execute(cmd); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = src.Reconcile(ctx, reconcileTopology(), reconcileOwnerKey); err != nil {
+		t.Fatal(err)
+	}
+	cmd, err = src.MeterAdd("meter1", "drop", 100, "pktps", nil, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = src.Execute(cmd); err != nil {
+		t.Fatal(err)
+	}
+
+	snapshot, err := src.Snapshot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, goovn.DBNB, snapshot.Database)
+	assert.Equal(t, []goovn.SnapshotRow{{
+		Name: "#1",
+		Columns: map[string]interface{}{
+			"action":    "allow-related",
+			"direction": "from-lport",
+			"match":     "ip4",
+			"priority":  1000,
+		},
+	}, {
+		Name: "#2",
+		Columns: map[string]interface{}{
+			"action":    "drop",
+			"direction": "to-lport",
+			"match":     "tcp.dst == 22",
+			"priority":  1001,
+		},
+	}}, snapshot.Tables[goovn.TableACL])
+	ls := snapshot.Tables[goovn.TableLogicalSwitch]
+	if assert.Len(t, ls, 1) {
+		assert.Equal(t, "ls1", ls[0].Name)
+		assert.Equal(t, []interface{}{"#2"}, ls[0].Columns["acls"])
+		assert.Equal(t, []interface{}{"ls1-lr1", "p1", "p2"}, ls[0].Columns["ports"])
+	}
+	assert.Equal(t, []interface{}{"#1"}, snapshot.Tables[goovn.TableMeter][0].Columns["bands"])
+
+	for _, encoding := range []string{goovn.SnapshotJSON, goovn.SnapshotYAML} {
+		var buf bytes.Buffer
+		if err := snapshot.Encode(&buf, encoding); err != nil {
+			t.Fatal(err)
+		}
+		decoded, err := goovn.DecodeSnapshot(&buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		dst := goovntest.NewClient(t, goovn.DBNB)
+		if err := dst.Import(ctx, global, 0); err != nil {
+			t.Fatal(err)
+		}
+		// Small batches split the rows in several transactions, the
+		// NB_Global row is updated
+		if err := dst.Import(ctx, decoded, 2); err != nil {
+			t.Fatal(err)
+		}
+		copied, err := dst.Snapshot()
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, snapshot, copied, encoding)
+		pg, err := dst.PortGroupGet("pg1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Len(t, pg.Ports, 2)
+
+		// Only empty tables are imported into
+		err = dst.Import(ctx, decoded, 0)
+		assert.True(t, errors.Is(err, goovn.ErrorExist), encoding)
+	}
+}
@@ -0,0 +1,131 @@
+/**
+ * Copyright (c) 2020 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn
+
+import (
+	"fmt"
+
+	"github.com/ebay/libovsdb"
+)
+
+// SSL ovnnb/ovnsb item, the SSL configuration of the db server
+type SSL struct {
+	UUID            string
+	PrivateKey      string
+	Certificate     string
+	CACert          string
+	BootstrapCACert bool
+	SSLProtocols    string
+	SSLCiphers      string
+	ExternalID      map[interface{}]interface{}
+	PopulatedColumns
+}
+
+// Replace the SSL configuration of the global table, like ovn-nbctl set-ssl.
+// The previous SSL row is garbage collected.
+func (odbi *ovndb) sslSetImp(ssl *SSL) (*OvnCommand, error) {
+	if ssl == nil || ssl.PrivateKey == "" || ssl.Certificate == "" || ssl.CACert == "" {
+		return nil, fmt.Errorf("private key, certificate and CA certificate are required")
+	}
+	namedUUID, err := newRowUUID()
+	if err != nil {
+		return nil, err
+	}
+	row := make(OVNRow)
+	row["private_key"] = ssl.PrivateKey
+	row["certificate"] = ssl.Certificate
+	row["ca_cert"] = ssl.CACert
+	row["bootstrap_ca_cert"] = ssl.BootstrapCACert
+	if ssl.SSLProtocols != "" {
+		row["ssl_protocols"] = ssl.SSLProtocols
+	}
+	if ssl.SSLCiphers != "" {
+		row["ssl_ciphers"] = ssl.SSLCiphers
+	}
+	if ssl.ExternalID != nil {
+		oMap, err := libovsdb.NewOvsMap(ssl.ExternalID)
+		if err != nil {
+			return nil, err
+		}
+		row["external_ids"] = oMap
+	}
+	insertOp := libovsdb.Operation{
+		Op:       opInsert,
+		Table:    TableSSL,
+		Row:      row,
+		UUIDName: namedUUID,
+	}
+	updateOp, err := odbi.globalUpdateOp("ssl", stringToGoUUID(namedUUID))
+	if err != nil {
+		return nil, err
+	}
+	operations := []libovsdb.Operation{insertOp, updateOp}
+	return &OvnCommand{operations, odbi, make([][]map[string]interface{}, len(operations))}, nil
+}
+
+// Remove the SSL configuration of the global table, like ovn-nbctl del-ssl
+func (odbi *ovndb) sslDelImp() (*OvnCommand, error) {
+	updateOp, err := odbi.globalUpdateOp("ssl", libovsdb.OvsSet{GoSet: []interface{}{}})
+	if err != nil {
+		return nil, err
+	}
+	operations := []libovsdb.Operation{updateOp}
+	return &OvnCommand{operations, odbi, make([][]map[string]interface{}, len(operations))}, nil
+}
+
+// Get the SSL configuration of the global table
+func (odbi *ovndb) sslGetImp() (*SSL, error) {
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+
+	if _, ok := odbi.tableCols[odbi.globalTable()]; !ok {
+		return nil, ErrorSchema
+	}
+	// tables without rows are not in the cache
+	cacheGlobal := odbi.cache[odbi.globalTable()]
+	if len(cacheGlobal) == 0 {
+		return nil, ErrorNotFound
+	}
+	for _, drows := range cacheGlobal {
+		for _, uuid := range rowSet(drows, "ssl") {
+			if ssl := odbi.rowToSSL(uuid); ssl != nil {
+				return ssl, nil
+			}
+		}
+	}
+	return nil, ErrorNotFound
+}
+
+func (odbi *ovndb) rowToSSL(uuid string) *SSL {
+	cacheSSL, ok := odbi.cache[TableSSL][uuid]
+	if !ok {
+		return nil
+	}
+
+	ssl := &SSL{
+		UUID:            uuid,
+		PrivateKey:      rowString(cacheSSL, "private_key"),
+		Certificate:     rowString(cacheSSL, "certificate"),
+		CACert:          rowString(cacheSSL, "ca_cert"),
+		BootstrapCACert: rowBool(cacheSSL, "bootstrap_ca_cert"),
+		SSLProtocols:    rowString(cacheSSL, "ssl_protocols"),
+		SSLCiphers:      rowString(cacheSSL, "ssl_ciphers"),
+		ExternalID:      rowMap(cacheSSL, "external_ids"),
+	}
+	ssl.PopulatedColumns = odbi.populated[TableSSL]
+	return ssl
+}
@@ -0,0 +1,49 @@
+/**
+ * Copyright (c) 2020 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn_test
+
+import (
+	"testing"
+	"time"
+
+	goovn "github.com/ebay/go-ovn"
+	"github.com/ebay/go-ovn/goovntest"
+	"github.com/ebay/libovsdb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGlobalRowNotFound(t *testing.T) {
+	for _, db := range []string{goovn.DBNB, goovn.DBSB} {
+		c := goovntest.NewClient(t, db)
+		_, err := c.ConnectionGet()
+		assert.Equal(t, goovn.ErrorNotFound, err)
+		_, err = c.SSLGet()
+		assert.Equal(t, goovn.ErrorNotFound, err)
+
+		table := goovn.TableNBGlobal
+		if db == goovn.DBSB {
+			table = goovn.TableSBGlobal
+		}
+		execute(t, c, libovsdb.Operation{Op: "insert", Table: table, Row: map[string]interface{}{}})
+		assert.Eventually(t, func() bool {
+			conns, err := c.ConnectionGet()
+			return err == nil && len(conns) == 0
+		}, 5*time.Second, 10*time.Millisecond)
+		_, err = c.SSLGet()
+		assert.Equal(t, goovn.ErrorNotFound, err)
+	}
+}
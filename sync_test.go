@@ -0,0 +1,82 @@
+/**
+ * Copyright (c) 2020 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	goovn "github.com/ebay/go-ovn"
+	"github.com/ebay/go-ovn/goovntest"
+	"github.com/ebay/libovsdb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWaitForSync(t *testing.T) {
+	c := goovntest.NewClient(t, goovn.DBNB)
+	execute(t, c, libovsdb.Operation{Op: "insert", Table: goovn.TableNBGlobal,
+		Row: map[string]interface{}{"nb_cfg": 0}})
+	assert.Eventually(t, func() bool {
+		_, err := c.NBGlobalGet()
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// act as ovn-northd, copying nb_cfg to sb_cfg
+	_, err := c.AddEventHandler(goovn.TableNBGlobal, nil, func(event *goovn.Event) {
+		nbGlobal := event.New.(*goovn.NBGlobalTableRow)
+		if nbGlobal.SbCfg >= nbGlobal.NbCfg {
+			return
+		}
+		go c.Execute(&goovn.OvnCommand{Operations: []libovsdb.Operation{{Op: "update", Table: goovn.TableNBGlobal,
+			Where: []interface{}{libovsdb.NewCondition("_uuid", "==", libovsdb.UUID{GoUUID: nbGlobal.UUID})},
+			Row:   map[string]interface{}{"sb_cfg": nbGlobal.NbCfg}}}})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	cmd, err := c.LSAdd("ls1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := c.WaitForSync(ctx, goovn.SyncSB, cmd); err != nil {
+		t.Fatal(err)
+	}
+	nbGlobal, err := c.NBGlobalGet()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 1, nbGlobal.NbCfg)
+	assert.Equal(t, 1, nbGlobal.SbCfg)
+	_, err = c.LSGet("ls1")
+	assert.NoError(t, err)
+}
+
+func TestWaitForSyncNotMonitored(t *testing.T) {
+	c := goovntest.NewClientWithConfig(t, &goovn.Config{Db: goovn.DBNB,
+		TableCols: map[string][]string{goovn.TableNBGlobal: {"nb_cfg", "sb_cfg"}}})
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	assert.Equal(t, goovn.ErrorSchema, c.WaitForSync(ctx, goovn.SyncHV))
+
+	c = goovntest.NewClientWithConfig(t, &goovn.Config{Db: goovn.DBNB,
+		TableCols: map[string][]string{goovn.TableLogicalSwitch: {}}})
+	assert.Equal(t, goovn.ErrorSchema, c.WaitForSync(ctx, goovn.SyncSB))
+}
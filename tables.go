@@ -0,0 +1,47 @@
+// Code generated by modelgen from the OVN_Northbound and OVN_Southbound schemas. DO NOT EDIT.
+
+package goovn
+
+// Tables of OVN_Northbound and OVN_Southbound
+const (
+	TableACL                      string = "ACL"
+	TableAddressSet               string = "Address_Set"
+	TableChassis                  string = "Chassis"
+	TableChassisPrivate           string = "Chassis_Private"
+	TableConnection               string = "Connection"
+	TableControllerEvent          string = "Controller_Event"
+	TableDHCPOptions              string = "DHCP_Options"
+	TableDHCPv6Options            string = "DHCPv6_Options"
+	TableDNS                      string = "DNS"
+	TableDatapathBinding          string = "Datapath_Binding"
+	TableEncap                    string = "Encap"
+	TableForwardingGroup          string = "Forwarding_Group"
+	TableGatewayChassis           string = "Gateway_Chassis"
+	TableHAChassis                string = "HA_Chassis"
+	TableHAChassisGroup           string = "HA_Chassis_Group"
+	TableIGMPGroup                string = "IGMP_Group"
+	TableIPMulticast              string = "IP_Multicast"
+	TableLoadBalancer             string = "Load_Balancer"
+	TableLoadBalancerHealthCheck  string = "Load_Balancer_Health_Check"
+	TableLogicalDPGroup           string = "Logical_DP_Group"
+	TableLogicalFlow              string = "Logical_Flow"
+	TableLogicalRouter            string = "Logical_Router"
+	TableLogicalRouterPolicy      string = "Logical_Router_Policy"
+	TableLogicalRouterPort        string = "Logical_Router_Port"
+	TableLogicalRouterStaticRoute string = "Logical_Router_Static_Route"
+	TableLogicalSwitch            string = "Logical_Switch"
+	TableLogicalSwitchPort        string = "Logical_Switch_Port"
+	TableMACBinding               string = "MAC_Binding"
+	TableMeter                    string = "Meter"
+	TableMeterBand                string = "Meter_Band"
+	TableNAT                      string = "NAT"
+	TableNBGlobal                 string = "NB_Global"
+	TablePortBinding              string = "Port_Binding"
+	TablePortGroup                string = "Port_Group"
+	TableQoS                      string = "QoS"
+	TableRBACPermission           string = "RBAC_Permission"
+	TableRBACRole                 string = "RBAC_Role"
+	TableSBGlobal                 string = "SB_Global"
+	TableSSL                      string = "SSL"
+	TableServiceMonitor           string = "Service_Monitor"
+)
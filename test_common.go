@@ -43,7 +43,7 @@ const (
 	defaultClientCACert  = "/etc/openvswitch/client_ca_cert.pem"
 	defaultClientPrivKey = "/etc/openvswitch/ovnnb-privkey.pem"
 	SKIP_TLS_VERIFY      = true
-	SSL                  = "ssl"
+	SSL_PROTOCOL         = "ssl"
 	UNIX                 = "unix"
 	FAKENOCHASSIS        = "fakenochassis"
 	FAKENOSWITCH         = "fakenoswitch"
@@ -82,46 +82,64 @@ var (
 
 type signal struct{}
 
-func (s signal) OnLogicalSwitchCreate(ls *LogicalSwitch) {}
-func (s signal) OnLogicalSwitchDelete(ls *LogicalSwitch) {}
+func (s signal) OnLogicalSwitchCreate(ls *LogicalSwitch)       {}
+func (s signal) OnLogicalSwitchDelete(ls *LogicalSwitch)       {}
+func (s signal) OnLogicalSwitchUpdate(old, new *LogicalSwitch) {}
 
-func (s signal) OnLogicalPortCreate(lp *LogicalSwitchPort) {}
-func (s signal) OnLogicalPortDelete(lp *LogicalSwitchPort) {}
+func (s signal) OnLogicalPortCreate(lp *LogicalSwitchPort)       {}
+func (s signal) OnLogicalPortDelete(lp *LogicalSwitchPort)       {}
+func (s signal) OnLogicalPortUpdate(old, new *LogicalSwitchPort) {}
 
-func (s signal) OnLogicalRouterCreate(lr *LogicalRouter) {}
-func (s signal) OnLogicalRouterDelete(lr *LogicalRouter) {}
+func (s signal) OnLogicalRouterCreate(lr *LogicalRouter)       {}
+func (s signal) OnLogicalRouterDelete(lr *LogicalRouter)       {}
+func (s signal) OnLogicalRouterUpdate(old, new *LogicalRouter) {}
 
-func (s signal) OnLogicalRouterPortCreate(lrp *LogicalRouterPort) {}
-func (s signal) OnLogicalRouterPortDelete(lrp *LogicalRouterPort) {}
+func (s signal) OnLogicalRouterPortCreate(lrp *LogicalRouterPort)      {}
+func (s signal) OnLogicalRouterPortDelete(lrp *LogicalRouterPort)      {}
+func (s signal) OnLogicalRouterPortUpdate(old, new *LogicalRouterPort) {}
 
-func (s signal) OnLogicalRouterStaticRouteCreate(lrsr *LogicalRouterStaticRoute) {}
-func (s signal) OnLogicalRouterStaticRouteDelete(lrsr *LogicalRouterStaticRoute) {}
+func (s signal) OnLogicalRouterStaticRouteCreate(lrsr *LogicalRouterStaticRoute)     {}
+func (s signal) OnLogicalRouterStaticRouteDelete(lrsr *LogicalRouterStaticRoute)     {}
+func (s signal) OnLogicalRouterStaticRouteUpdate(old, new *LogicalRouterStaticRoute) {}
 
-func (s signal) OnACLCreate(acl *ACL) {}
-func (s signal) OnACLDelete(acl *ACL) {}
+func (s signal) OnACLCreate(acl *ACL)      {}
+func (s signal) OnACLDelete(acl *ACL)      {}
+func (s signal) OnACLUpdate(old, new *ACL) {}
 
-func (s signal) OnDHCPOptionsCreate(dhcp *DHCPOptions) {}
-func (s signal) OnDHCPOptionsDelete(dhcp *DHCPOptions) {}
+func (s signal) OnDHCPOptionsCreate(dhcp *DHCPOptions)     {}
+func (s signal) OnDHCPOptionsDelete(dhcp *DHCPOptions)     {}
+func (s signal) OnDHCPOptionsUpdate(old, new *DHCPOptions) {}
 
-func (s signal) OnQoSCreate(qos *QoS) {}
-func (s signal) OnQoSDelete(qos *QoS) {}
+func (s signal) OnQoSCreate(qos *QoS)      {}
+func (s signal) OnQoSDelete(qos *QoS)      {}
+func (s signal) OnQoSUpdate(old, new *QoS) {}
 
-func (s signal) OnLoadBalancerCreate(ls *LoadBalancer) {}
-func (s signal) OnLoadBalancerDelete(ls *LoadBalancer) {}
+func (s signal) OnLoadBalancerCreate(ls *LoadBalancer)       {}
+func (s signal) OnLoadBalancerDelete(ls *LoadBalancer)       {}
+func (s signal) OnLoadBalancerUpdate(old, new *LoadBalancer) {}
 
-func (s signal) OnMeterCreate(meter *Meter) {}
-func (s signal) OnMeterDelete(meter *Meter) {}
+func (s signal) OnMeterCreate(meter *Meter)    {}
+func (s signal) OnMeterDelete(meter *Meter)    {}
+func (s signal) OnMeterUpdate(old, new *Meter) {}
 
-func (s signal) OnMeterBandCreate(band *MeterBand) {}
-func (s signal) OnMeterBandDelete(band *MeterBand) {}
+func (s signal) OnMeterBandCreate(band *MeterBand)     {}
+func (s signal) OnMeterBandDelete(band *MeterBand)     {}
+func (s signal) OnMeterBandUpdate(old, new *MeterBand) {}
 
-// Create/delete chassis from south bound db
-func (s signal) OnChassisCreate(ch *Chassis) {}
-func (s signal) OnChassisDelete(ch *Chassis) {}
+// Create/update/delete chassis from south bound db
+func (s signal) OnChassisCreate(ch *Chassis)       {}
+func (s signal) OnChassisDelete(ch *Chassis)       {}
+func (s signal) OnChassisUpdate(old, new *Chassis) {}
 
-// Create/delete encap from south bound db
-func (s signal) OnEncapCreate(ch *Encap) {}
-func (s signal) OnEncapDelete(ch *Encap) {}
+// Create/update/delete encap from south bound db
+func (s signal) OnEncapCreate(ch *Encap)       {}
+func (s signal) OnEncapDelete(ch *Encap)       {}
+func (s signal) OnEncapUpdate(old, new *Encap) {}
+
+// Create/update/delete port binding from south bound db
+func (s signal) OnPortBindingCreate(pb *PortBinding)       {}
+func (s signal) OnPortBindingUpdate(old, new *PortBinding) {}
+func (s signal) OnPortBindingDelete(pb *PortBinding)       {}
 
 func buildOvnDbConfig(db string) *Config {
 	cfg := &Config{}
@@ -152,7 +170,7 @@ func buildOvnDbConfig(db string) *Config {
 		} else {
 			port, _ := strconv.Atoi(strs[2])
 			protocol := strs[0]
-			if protocol == SSL {
+			if protocol == SSL_PROTOCOL {
 				clientCACert := os.Getenv("CLIENT_CERT_CA_CERT")
 				if clientCACert == "" {
 					clientCACert = defaultClientCACert
@@ -0,0 +1,690 @@
+/**
+ * Copyright (c) 2020 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/ebay/libovsdb"
+)
+
+const (
+	// DefaultTxnMaxRetries is how many times Commit rebuilds and resends a
+	// transaction whose preconditions no longer hold on the server.
+	DefaultTxnMaxRetries = 3
+
+	txnRetryInterval = 100 * time.Millisecond
+	// libovsdb omits a zero timeout, and a wait without timeout blocks
+	// forever on the server, so use the smallest non-zero one instead.
+	txnWaitTimeout = 1
+)
+
+// TxnBuilder builds a command using c, a view of the client cache that
+// already reflects every command staged before it in the same Transaction.
+// Builders are run again when a Transaction is retried, so they should only
+// build the command and not execute anything themselves.
+type TxnBuilder func(c Client) (*OvnCommand, error)
+
+// Transaction stages multiple commands into a single OVSDB transaction.
+//
+// Commands are built against a private overlay of the client cache, so e.g.
+// LSPAdd on a switch added by an earlier LSAdd of the same transaction works.
+// Every row looked up by name while building is guarded by a "wait" operation,
+// and when the server reports that one of them changed in the meantime, Commit
+// rebuilds all the commands from the updated cache and tries again.
+//
+// A Transaction is not safe for concurrent use.
+type Transaction struct {
+	// MaxRetries bounds how often Commit rebuilds the transaction on conflict
+	MaxRetries int
+
+	odbi     *ovndb
+	builders []TxnBuilder
+	view     *ovndb
+	written  map[string]bool
+	ops      []libovsdb.Operation
+}
+
+func (odbi *ovndb) newTransaction() *Transaction {
+	return &Transaction{
+		MaxRetries: DefaultTxnMaxRetries,
+		odbi:       odbi,
+	}
+}
+
+// Add builds a command against the transaction view and stages it.
+// Errors of the builder are returned as is and nothing is staged.
+func (txn *Transaction) Add(builder TxnBuilder) error {
+	if txn.view == nil {
+		txn.snapshot()
+	}
+	if err := txn.stage(builder); err != nil {
+		return err
+	}
+	txn.builders = append(txn.builders, builder)
+	return nil
+}
+
+// Commit sends all staged commands in one transaction and returns a UUID for
+// each object created, like ExecuteR. The transaction is empty afterwards,
+// whether the commit succeeded or not.
+func (txn *Transaction) Commit() ([]string, error) {
+	return txn.CommitContext(context.Background())
+}
+
+// CommitContext is Commit with a context that bounds the transact requests
+// and the waits between retries.
+func (txn *Transaction) CommitContext(ctx context.Context) ([]string, error) {
+	defer txn.Rollback()
+
+	for attempt := 0; len(txn.ops) > 0; attempt++ {
+		waits := txn.view.txnReads.waits()
+		ops := append(waits, txn.ops...)
+		reply, err := txn.odbi.transactRaw(ctx, txn.odbi.db, ops...)
+		if err != nil {
+			return nil, err
+		}
+		if !isTxnConflict(reply, ops) {
+			results, err := checkOperationResults(reply, ops)
+			if err != nil {
+				return nil, err
+			}
+			return resultUUIDs(results[len(waits):]), nil
+		}
+		if attempt >= txn.MaxRetries {
+			return nil, ErrorTxnConflict
+		}
+		// give the monitor a chance to bring the cache up to date
+		select {
+		case <-time.After(time.Duration(attempt+1) * txnRetryInterval):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		if err := txn.rebuild(); err != nil {
+			return nil, err
+		}
+	}
+	return nil, nil
+}
+
+// Rollback discards all staged commands.
+func (txn *Transaction) Rollback() {
+	txn.builders = nil
+	txn.view = nil
+	txn.written = nil
+	txn.ops = nil
+}
+
+func (txn *Transaction) snapshot() {
+	txn.odbi.cachemutex.RLock()
+	defer txn.odbi.cachemutex.RUnlock()
+
+	base := make(map[string]map[string]libovsdb.Row, len(txn.odbi.cache))
+	cache := make(map[string]map[string]libovsdb.Row, len(txn.odbi.cache))
+	for table, rows := range txn.odbi.cache {
+		copied := make(map[string]libovsdb.Row, len(rows))
+		for uuid, row := range rows {
+			copied[uuid] = row
+		}
+		base[table] = copied
+		// tables of the view are copied again before their first change
+		cache[table] = copied
+	}
+
+	// the view has the configuration, populated columns and models of the
+	// client, only its cache differs
+	txn.view = &ovndb{
+		ovndbConfig: txn.odbi.ovndbConfig,
+		client:      txn.odbi.client,
+		cache:       cache,
+		populated:   txn.odbi.populated,
+		models:      txn.odbi.models,
+		txnReads:    &txnReadSet{base: base, seen: make(map[string]bool)},
+	}
+	txn.written = make(map[string]bool)
+	txn.ops = nil
+}
+
+func (txn *Transaction) rebuild() error {
+	builders := txn.builders
+	txn.snapshot()
+	for _, builder := range builders {
+		if err := txn.stage(builder); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (txn *Transaction) stage(builder TxnBuilder) error {
+	cmd, err := builder(txn.view)
+	if err != nil {
+		return err
+	}
+	if cmd == nil {
+		return nil
+	}
+	if err := txn.apply(cmd.Operations); err != nil {
+		return err
+	}
+	txn.ops = append(txn.ops, cmd.Operations...)
+	return nil
+}
+
+func isTxnConflict(reply []libovsdb.OperationResult, ops []libovsdb.Operation) bool {
+	for i, r := range reply {
+		if i < len(ops) && ops[i].Op == opWait && r.Error == TxnErrorTimedOut {
+			return true
+		}
+	}
+	return false
+}
+
+// table returns the rows of table in the view, copied so they can be changed
+func (txn *Transaction) table(table string) map[string]libovsdb.Row {
+	if !txn.written[table] {
+		rows := make(map[string]libovsdb.Row, len(txn.view.cache[table]))
+		for uuid, row := range txn.view.cache[table] {
+			rows[uuid] = row
+		}
+		txn.view.cache[table] = rows
+		txn.written[table] = true
+	}
+	return txn.view.cache[table]
+}
+
+// apply replays operations on the view the same way ovsdb-server would,
+// except that rows of non-root tables are not garbage collected.
+func (txn *Transaction) apply(ops []libovsdb.Operation) error {
+	for _, op := range ops {
+		if op.Op != opInsert && op.Op != opUpdate && op.Op != opMutate && op.Op != opDelete {
+			continue
+		}
+		columns := txn.view.tableColumnTypes(op.Table)
+		if columns == nil {
+			return fmt.Errorf("table %s not found in %s schema", op.Table, txn.view.db)
+		}
+		if op.Op == opInsert {
+			if err := txn.applyInsert(op, columns); err != nil {
+				return err
+			}
+			continue
+		}
+
+		uuids, err := txn.match(op.Table, op.Where)
+		if err != nil {
+			return err
+		}
+		rows := txn.table(op.Table)
+		for _, uuid := range uuids {
+			if op.Op == opDelete {
+				delete(rows, uuid)
+				continue
+			}
+			fields := make(map[string]interface{}, len(rows[uuid].Fields))
+			for column, value := range rows[uuid].Fields {
+				fields[column] = value
+			}
+			if op.Op == opUpdate {
+				for column, value := range op.Row {
+					v, err := ovsdbValue(value)
+					if err != nil {
+						return err
+					}
+					fields[column] = columns[column].store(v)
+				}
+			} else {
+				for _, m := range op.Mutations {
+					mutation, ok := m.([]interface{})
+					if !ok || len(mutation) != 3 {
+						return fmt.Errorf("invalid mutation %v", m)
+					}
+					column, _ := mutation[0].(string)
+					mutator, _ := mutation[1].(string)
+					v, err := ovsdbValue(mutation[2])
+					if err != nil {
+						return err
+					}
+					mutated, err := mutateValue(fields[column], mutator, v, columns[column])
+					if err != nil {
+						return err
+					}
+					fields[column] = mutated
+				}
+			}
+			rows[uuid] = libovsdb.Row{Fields: fields}
+		}
+	}
+	return nil
+}
+
+func (txn *Transaction) applyInsert(op libovsdb.Operation, columns map[string]columnType) error {
+	fields := make(map[string]interface{}, len(columns))
+	for column, ct := range columns {
+		fields[column] = ct.defaultValue()
+	}
+	for column, value := range op.Row {
+		v, err := ovsdbValue(value)
+		if err != nil {
+			return err
+		}
+		fields[column] = columns[column].store(v)
+	}
+	uuid := op.UUIDName
+	if uuid == "" {
+		var err error
+		if uuid, err = newRowUUID(); err != nil {
+			return err
+		}
+	}
+	txn.table(op.Table)[uuid] = libovsdb.Row{Fields: fields}
+	return nil
+}
+
+// match returns the UUIDs of the rows of table in the view that satisfy where
+func (txn *Transaction) match(table string, where []interface{}) ([]string, error) {
+	var uuids []string
+	for uuid, row := range txn.view.cache[table] {
+		matched := true
+		for _, c := range where {
+			condition, ok := c.([]interface{})
+			if !ok || len(condition) != 3 {
+				return nil, fmt.Errorf("invalid condition %v", c)
+			}
+			column, _ := condition[0].(string)
+			function, _ := condition[1].(string)
+			expected, err := ovsdbValue(condition[2])
+			if err != nil {
+				return nil, err
+			}
+			var actual interface{}
+			if column == "_uuid" {
+				actual = stringToGoUUID(uuid)
+			} else {
+				actual = row.Fields[column]
+			}
+			if ok, err := evalCondition(actual, function, expected); err != nil {
+				return nil, err
+			} else if !ok {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			uuids = append(uuids, uuid)
+		}
+	}
+	return uuids, nil
+}
+
+// ovsdbValue converts a value as passed in an operation (e.g. *libovsdb.OvsSet
+// or a named UUID) to the form it takes in the cache.
+func ovsdbValue(value interface{}) (interface{}, error) {
+	b, err := json.Marshal(map[string]interface{}{"v": value})
+	if err != nil {
+		return nil, err
+	}
+	var row libovsdb.Row
+	if err := json.Unmarshal(b, &row); err != nil {
+		return nil, err
+	}
+	return fromNotation(row.Fields["v"]), nil
+}
+
+func fromNotation(value interface{}) interface{} {
+	switch v := value.(type) {
+	case float64:
+		if n := int(v); float64(n) == v {
+			return n
+		}
+	case []interface{}:
+		// named UUIDs are left alone by libovsdb
+		if len(v) == 2 && v[0] == "named-uuid" {
+			if name, ok := v[1].(string); ok {
+				return stringToGoUUID(name)
+			}
+		}
+	case libovsdb.OvsSet:
+		elems := make([]interface{}, 0, len(v.GoSet))
+		for _, e := range v.GoSet {
+			elems = append(elems, fromNotation(e))
+		}
+		return libovsdb.OvsSet{GoSet: elems}
+	}
+	return value
+}
+
+// store converts v to the shape ovsdb-server uses for the column in updates:
+// a set with exactly one element is sent as that element.
+func (ct columnType) store(v interface{}) interface{} {
+	if !ct.isSet() {
+		return v
+	}
+	return setValue(setElems(v))
+}
+
+func setValue(elems []interface{}) interface{} {
+	if len(elems) == 1 {
+		return elems[0]
+	}
+	return libovsdb.OvsSet{GoSet: elems}
+}
+
+func setElems(v interface{}) []interface{} {
+	switch s := v.(type) {
+	case nil:
+		return nil
+	case libovsdb.OvsSet:
+		return s.GoSet
+	}
+	return []interface{}{v}
+}
+
+func atomEqual(a, b interface{}) bool {
+	if fa, ok := toFloat(a); ok {
+		fb, ok := toFloat(b)
+		return ok && fa == fb
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+func toFloat(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case int:
+		return float64(n), true
+	case float64:
+		return n, true
+	}
+	return 0, false
+}
+
+func setContains(elems []interface{}, v interface{}) bool {
+	for _, e := range elems {
+		if atomEqual(e, v) {
+			return true
+		}
+	}
+	return false
+}
+
+func mapContains(m, sub map[interface{}]interface{}) bool {
+	for k, v := range sub {
+		if mv, ok := m[k]; !ok || !atomEqual(mv, v) {
+			return false
+		}
+	}
+	return true
+}
+
+func evalCondition(actual interface{}, function string, expected interface{}) (bool, error) {
+	if am, ok := actual.(libovsdb.OvsMap); ok {
+		em, _ := expected.(libovsdb.OvsMap)
+		switch function {
+		case "==":
+			return len(am.GoMap) == len(em.GoMap) && mapContains(am.GoMap, em.GoMap), nil
+		case "!=":
+			return !(len(am.GoMap) == len(em.GoMap) && mapContains(am.GoMap, em.GoMap)), nil
+		case "includes":
+			return mapContains(am.GoMap, em.GoMap), nil
+		case "excludes":
+			for k, v := range em.GoMap {
+				if mv, ok := am.GoMap[k]; ok && atomEqual(mv, v) {
+					return false, nil
+				}
+			}
+			return true, nil
+		}
+		return false, fmt.Errorf("function %s not supported on maps", function)
+	}
+
+	a, e := setElems(actual), setElems(expected)
+	switch function {
+	case "==", "!=":
+		equal := len(a) == len(e)
+		for _, v := range e {
+			equal = equal && setContains(a, v)
+		}
+		return equal == (function == "=="), nil
+	case "includes":
+		for _, v := range e {
+			if !setContains(a, v) {
+				return false, nil
+			}
+		}
+		return true, nil
+	case "excludes":
+		for _, v := range e {
+			if setContains(a, v) {
+				return false, nil
+			}
+		}
+		return true, nil
+	case "<", "<=", ">", ">=":
+		fa, ok := toFloat(actual)
+		fe, ok2 := toFloat(expected)
+		if !ok || !ok2 {
+			return false, fmt.Errorf("function %s requires numbers", function)
+		}
+		switch function {
+		case "<":
+			return fa < fe, nil
+		case "<=":
+			return fa <= fe, nil
+		case ">":
+			return fa > fe, nil
+		}
+		return fa >= fe, nil
+	}
+	return false, fmt.Errorf("unknown function %s", function)
+}
+
+func mutateValue(current interface{}, mutator string, value interface{}, ct columnType) (interface{}, error) {
+	if ct.isMap() {
+		cm, _ := current.(libovsdb.OvsMap)
+		mutated := make(map[interface{}]interface{}, len(cm.GoMap))
+		for k, v := range cm.GoMap {
+			mutated[k] = v
+		}
+		switch mutator {
+		case opInsert:
+			vm, _ := value.(libovsdb.OvsMap)
+			for k, v := range vm.GoMap {
+				if _, ok := mutated[k]; !ok {
+					mutated[k] = v
+				}
+			}
+		case opDelete:
+			if vm, ok := value.(libovsdb.OvsMap); ok {
+				for k, v := range vm.GoMap {
+					if mv, ok := mutated[k]; ok && atomEqual(mv, v) {
+						delete(mutated, k)
+					}
+				}
+			} else {
+				for _, k := range setElems(value) {
+					delete(mutated, k)
+				}
+			}
+		default:
+			return nil, fmt.Errorf("mutator %s not supported on maps", mutator)
+		}
+		return libovsdb.OvsMap{GoMap: mutated}, nil
+	}
+
+	if ct.isSet() && (mutator == opInsert || mutator == opDelete) {
+		var mutated []interface{}
+		if mutator == opInsert {
+			mutated = append(mutated, setElems(current)...)
+			for _, v := range setElems(value) {
+				if !setContains(mutated, v) {
+					mutated = append(mutated, v)
+				}
+			}
+		} else {
+			for _, v := range setElems(current) {
+				if !setContains(setElems(value), v) {
+					mutated = append(mutated, v)
+				}
+			}
+		}
+		return setValue(mutated), nil
+	}
+
+	switch c := current.(type) {
+	case int:
+		n, ok := value.(int)
+		if !ok {
+			return nil, fmt.Errorf("mutation of integer with %v", value)
+		}
+		switch mutator {
+		case "+=":
+			return c + n, nil
+		case "-=":
+			return c - n, nil
+		case "*=":
+			return c * n, nil
+		case "/=":
+			if n != 0 {
+				return c / n, nil
+			}
+		case "%=":
+			if n != 0 {
+				return c % n, nil
+			}
+		}
+	case float64:
+		f, ok := toFloat(value)
+		if !ok {
+			return nil, fmt.Errorf("mutation of real with %v", value)
+		}
+		switch mutator {
+		case "+=":
+			return c + f, nil
+		case "-=":
+			return c - f, nil
+		case "*=":
+			return c * f, nil
+		case "/=":
+			if f != 0 {
+				return c / f, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("mutator %s not supported on %v", mutator, current)
+}
+
+// txnRead is a lookup of rows by column values made while building commands
+type txnRead struct {
+	table     string
+	condition OVNRow
+}
+
+// txnReadSet records the lookups done on the view of a Transaction, to guard
+// them against the cache snapshot (base) they were made on.
+type txnReadSet struct {
+	base  map[string]map[string]libovsdb.Row
+	reads []txnRead
+	seen  map[string]bool
+}
+
+func isAtom(v interface{}) bool {
+	switch v.(type) {
+	case string, int, float64, bool:
+		return true
+	}
+	return false
+}
+
+func (r *txnReadSet) record(table string, row OVNRow) {
+	for _, v := range row {
+		if !isAtom(v) {
+			return
+		}
+	}
+	// fmt prints maps sorted by key
+	key := fmt.Sprintf("%s%v", table, row)
+	if r.seen[key] {
+		return
+	}
+	r.seen[key] = true
+	condition := make(OVNRow, len(row))
+	for k, v := range row {
+		condition[k] = v
+	}
+	r.reads = append(r.reads, txnRead{table, condition})
+}
+
+// waits returns "wait" operations that fail when the rows matching any of the
+// recorded lookups on the server differ from the ones in the snapshot.
+func (r *txnReadSet) waits() []libovsdb.Operation {
+	var waits []libovsdb.Operation
+	for _, read := range r.reads {
+		columns := make([]string, 0, len(read.condition))
+		for column := range read.condition {
+			columns = append(columns, column)
+		}
+		sort.Strings(columns)
+
+		where := make([]interface{}, 0, len(columns))
+		for _, column := range columns {
+			where = append(where, libovsdb.NewCondition(column, "==", read.condition[column]))
+		}
+
+		var rows []map[string]interface{}
+		for uuid, row := range r.base[read.table] {
+			matched := true
+			for column, value := range read.condition {
+				if v, ok := row.Fields[column]; ok && v != value {
+					matched = false
+					break
+				}
+			}
+			if matched {
+				projected := map[string]interface{}{"_uuid": stringToGoUUID(uuid)}
+				for column, value := range read.condition {
+					projected[column] = value
+				}
+				rows = append(rows, projected)
+			}
+		}
+
+		wait := libovsdb.Operation{
+			Op:      opWait,
+			Table:   read.table,
+			Where:   where,
+			Timeout: txnWaitTimeout,
+		}
+		if len(rows) == 0 {
+			// libovsdb omits empty rows, so the absence of a row is checked by
+			// requiring the result to differ from the row that must not exist.
+			wait.Columns = columns
+			wait.Until = "!="
+			wait.Rows = []map[string]interface{}{map[string]interface{}(read.condition)}
+		} else {
+			wait.Columns = append([]string{"_uuid"}, columns...)
+			wait.Until = "=="
+			wait.Rows = rows
+		}
+		waits = append(waits, wait)
+	}
+	return waits
+}
@@ -0,0 +1,138 @@
+/**
+ * Copyright (c) 2020 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	TXN_LS  = "TXN_LS"
+	TXN_LSP = "TXN_LSP"
+)
+
+func TestTransaction(t *testing.T) {
+	ovndbapi := getOVNClient(DBNB)
+
+	t.Logf("Adding %s and %s in one transaction", TXN_LS, TXN_LSP)
+	txn := ovndbapi.NewTransaction()
+	err := txn.Add(func(c Client) (*OvnCommand, error) {
+		return c.LSAdd(TXN_LS)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = txn.Add(func(c Client) (*OvnCommand, error) {
+		return c.LSPAdd(TXN_LS, TXN_LSP)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = txn.Add(func(c Client) (*OvnCommand, error) {
+		return c.LSPSetAddress(TXN_LSP, ADDR)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the port is already visible to the transaction
+	err = txn.Add(func(c Client) (*OvnCommand, error) {
+		return c.LSPAdd(TXN_LS, TXN_LSP)
+	})
+	assert.Equal(t, ErrorExist, err)
+
+	uuids, err := txn.Commit()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 2, len(uuids))
+
+	lsps, err := ovndbapi.LSPList(TXN_LS)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 1, len(lsps))
+	assert.Equal(t, TXN_LSP, lsps[0].Name)
+	assert.Equal(t, []string{ADDR}, lsps[0].Addresses)
+
+	t.Logf("Rolling back a transaction")
+	err = txn.Add(func(c Client) (*OvnCommand, error) {
+		return c.LSDel(TXN_LS)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	txn.Rollback()
+	uuids, err = txn.Commit()
+	assert.Nil(t, err)
+	assert.Nil(t, uuids)
+	ls, err := ovndbapi.LSGet(TXN_LS)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 1, len(ls))
+
+	t.Logf("Deleting %s", TXN_LS)
+	cmd, err := ovndbapi.LSDel(TXN_LS)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTransactionConflict(t *testing.T) {
+	ovndbapi := getOVNClient(DBNB)
+
+	// a switch added behind the back of the transaction makes its
+	// precondition fail, and the retry then sees the switch exists.
+	txn := ovndbapi.NewTransaction()
+	err := txn.Add(func(c Client) (*OvnCommand, error) {
+		return c.LSAdd(TXN_LS)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmd, err := ovndbapi.LSAdd(TXN_LS)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = txn.Commit()
+	assert.Equal(t, ErrorExist, err)
+
+	ls, err := ovndbapi.LSGet(TXN_LS)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 1, len(ls))
+
+	cmd, err = ovndbapi.LSDel(TXN_LS)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+}